@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+
+	"goapi/config"
+	"goapi/report"
+)
+
+func runCompare(args []string) error {
+	fs := flag.NewFlagSet("compare", flag.ExitOnError)
+	alpha := fs.Float64("alpha", report.DefaultAlpha, "Mann-Whitney U 检验的显著性水平")
+	thNs := fs.Float64("threshold-ns", report.DefaultThresholds.Ns, "ns/op 允许的最大相对增长")
+	thBytes := fs.Float64("threshold-bytes", report.DefaultThresholds.Bytes, "B/op 允许的最大相对增长")
+	thAllocs := fs.Float64("threshold-allocs", report.DefaultThresholds.Allocs, "allocs/op 允许的最大相对增长")
+	orms := fs.String("orms", report.ORMJorm, "参与门禁判断的 ORM，逗号分隔，其余 ORM 只输出对比")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: jormbench compare [flags] <baseline> [current]")
+		fmt.Fprintln(fs.Output(), "省略 current 时从标准输入读取 benchmark 输出")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	base, err := report.Load(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("load baseline: %w", err)
+	}
+	cur, err := loadResults(fs.Arg(1))
+	if err != nil {
+		return fmt.Errorf("load current results: %w", err)
+	}
+
+	th := report.Thresholds{Ns: *thNs, Bytes: *thBytes, Allocs: *thAllocs}
+	findings := report.Diff(base, cur, th, *alpha)
+	if err := report.WriteDiff(os.Stdout, base, cur, findings); err != nil {
+		return err
+	}
+
+	gated := config.SplitList(*orms)
+	var regressions int
+	for _, f := range findings {
+		if f.Regression && slices.Contains(gated, f.ORM) {
+			regressions++
+		}
+	}
+	if regressions > 0 {
+		return fmt.Errorf("%d significant regression(s) beyond threshold", regressions)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"strings"
+
+	"goapi/config"
+	"goapi/envinfo"
+	"goapi/report"
+	"goapi/scenario"
+)
+
+func runGC(args []string) error {
+	fs := flag.NewFlagSet("gc", flag.ExitOnError)
+	cfgPath := fs.String("config", "", "配置文件，默认查找 "+config.FileName)
+	scenarios := fs.String("scenarios", "Insert,FindAll", "场景，逗号分隔: "+scenarioNames())
+	orms := fs.String("orms", strings.Join(scenario.ORMs, ","), "ORM，逗号分隔")
+	rows := fs.Int("rows", 0, "预置数据量，默认使用每个场景自己的数据量")
+	storage := fs.String("storage", scenario.StorageFile, "SQLite 存储模式: "+strings.Join(scenario.Storages, ", "))
+	db := fs.String("db", defaultDB, "SQLite 数据库文件")
+	gogc := fs.String("gogc", "50,100,200,off", "GOGC 取值，逗号分隔，off 表示关闭 GC")
+	memlimit := fs.String("memlimit", "off", "GOMEMLIMIT 取值，逗号分隔，例如 off,64MiB,256MiB")
+	benchtime := fs.Duration("benchtime", 0, "每个组合的目标运行时间，默认 1s")
+	iterations := fs.Int("n", 0, "固定迭代次数，设置后忽略 -benchtime")
+	count := fs.Int("count", 1, "每个组合的运行次数")
+	out := fs.String("out", "", "把结果保存为基线 JSON")
+	fs.Parse(args)
+
+	cfg, err := loadConfig(fs, *cfgPath, *db)
+	if err != nil {
+		return err
+	}
+	selected, err := scenario.Select(config.SplitList(*scenarios))
+	if err != nil {
+		return err
+	}
+	ormList := config.SplitList(*orms)
+	for _, o := range ormList {
+		if !slices.Contains(scenario.ORMs, o) {
+			return fmt.Errorf("unknown orm %q (want %s)", o, strings.Join(scenario.ORMs, ", "))
+		}
+	}
+	gogcList := config.SplitList(*gogc)
+	limitList := config.SplitList(*memlimit)
+	for _, v := range gogcList {
+		if _, err := parseGOGC(v); err != nil {
+			return err
+		}
+	}
+	for _, v := range limitList {
+		if _, err := parseMemLimit(v); err != nil {
+			return err
+		}
+	}
+	target, err := scenario.NewTarget(cfg, *storage, defaultDB)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	// 结束后恢复进程原有的 GC 设置
+	defer debug.SetGCPercent(debug.SetGCPercent(100))
+	defer debug.SetMemoryLimit(debug.SetMemoryLimit(-1))
+
+	opts := scenario.Options{Benchtime: *benchtime, Iterations: *iterations, GC: true}
+	results := &report.Set{Config: envinfo.Collect(target.Mode())}
+	report.WriteText(os.Stdout, results)
+	fmt.Println("pkg: jormbench")
+	var rowsOut []gcRow
+	for _, g := range gogcList {
+		percent, _ := parseGOGC(g)
+		for _, l := range limitList {
+			limit, _ := parseMemLimit(l)
+			debug.SetGCPercent(percent)
+			debug.SetMemoryLimit(limit)
+			for i := 0; i < *count; i++ {
+				for _, s := range selected {
+					for _, orm := range ormList {
+						r, err := scenario.Run(target, orm, s, *rows, opts)
+						if err != nil {
+							return err
+						}
+						r.Scenario += "/gogc=" + g + "/memlimit=" + l
+						r.Name = scenario.ORMPrefix(orm) + r.Scenario
+						r.Package = "jormbench"
+						results.Results = append(results.Results, r)
+						rowsOut = append(rowsOut, gcRow{scenario: s.Name, gogc: g, memlimit: l, result: r})
+						fmt.Println(report.FormatLine(r))
+					}
+				}
+			}
+		}
+	}
+
+	// GC 汇总表写到标准错误，标准输出保持 go test -bench 格式
+	fmt.Fprintln(os.Stderr)
+	if err := writeGCTable(os.Stderr, rowsOut); err != nil {
+		return err
+	}
+	if *out != "" {
+		return report.NewBaseline(results).Save(*out)
+	}
+	return nil
+}
+
+// parseGOGC 解析 GOGC 取值，off 对应 -1
+func parseGOGC(s string) (int, error) {
+	if strings.EqualFold(s, "off") {
+		return -1, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid GOGC value %q", s)
+	}
+	return n, nil
+}
+
+// parseMemLimit 按 GOMEMLIMIT 的格式解析内存上限，例如 64MiB、1GiB、1000000 (字节)，off 表示不限制
+func parseMemLimit(s string) (int64, error) {
+	if strings.EqualFold(s, "off") {
+		return math.MaxInt64, nil
+	}
+	num, unit := s, int64(1)
+	for _, u := range []struct {
+		suffix string
+		size   int64
+	}{{"TiB", 1 << 40}, {"GiB", 1 << 30}, {"MiB", 1 << 20}, {"KiB", 1 << 10}, {"B", 1}} {
+		if strings.HasSuffix(s, u.suffix) {
+			num, unit = strings.TrimSuffix(s, u.suffix), u.size
+			break
+		}
+	}
+	n, err := strconv.ParseInt(num, 10, 64)
+	if err != nil || n <= 0 || n > math.MaxInt64/unit {
+		return 0, fmt.Errorf("invalid GOMEMLIMIT value %q", s)
+	}
+	return n * unit, nil
+}
+
+// gcRow 是 GC 汇总表的一行
+type gcRow struct {
+	scenario, gogc, memlimit string
+	result                   report.Result
+}
+
+func writeGCTable(w io.Writer, rows []gcRow) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "| 操作类型 | ORM 框架 | GOGC | GOMEMLIMIT | 执行时间 (ns/op) | 内存分配 (B/op) | GC 次数 (/op) | GC 停顿 (ns/op) | GC CPU (ns/op) | 堆峰值 (MiB) |")
+	fmt.Fprintln(bw, "|---------|---------|------|-----------|----------------|---------------|--------------|----------------|---------------|------------|")
+	for _, row := range rows {
+		r := row.result
+		fmt.Fprintf(bw, "| %s | %s | %s | %s | %s | %s | %.4f | %.1f | %s | %.1f |\n",
+			report.ScenarioLabel(row.scenario), strings.ToUpper(r.ORM), row.gogc, row.memlimit,
+			report.FormatInt(r.NsPerOp), report.FormatInt(r.BytesPerOp),
+			r.Metrics["gc/op"], r.Metrics["gc-pause-ns/op"], report.FormatInt(r.Metrics["gc-cpu-ns/op"]),
+			r.Metrics["peak-heap-B"]/(1<<20))
+	}
+	return bw.Flush()
+}
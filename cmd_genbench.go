@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"goapi/specgen"
+)
+
+func runGenBench(args []string) error {
+	fs := flag.NewFlagSet("genbench", flag.ExitOnError)
+	spec := fs.String("spec", "scenarios.yaml", "场景描述文件 (YAML 或 JSON)")
+	pkg := fs.String("pkg", "", "生成文件的包名，默认取描述文件中的 package 或 $GOPACKAGE (go generate 设置)")
+	out := fs.String("o", "scenarios_gen_test.go", "输出文件，- 表示 stdout")
+	fs.Parse(args)
+
+	f, err := os.Open(*spec)
+	if err != nil {
+		return err
+	}
+	file, err := specgen.Parse(f)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("%s: %w", *spec, err)
+	}
+	if *pkg == "" && file.Package == "" {
+		*pkg = os.Getenv("GOPACKAGE")
+	}
+	var buf bytes.Buffer
+	if err := specgen.Generate(&buf, file, *pkg, filepath.Base(*spec)); err != nil {
+		return err
+	}
+	if *out == "-" {
+		_, err := os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	if err := os.WriteFile(*out, buf.Bytes(), 0o644); err != nil {
+		return err
+	}
+	log.Printf("wrote %d scenarios x %d ORMs to %s", len(file.Scenarios), len(specgen.ORMs), *out)
+	return nil
+}
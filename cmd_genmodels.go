@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"goapi/modelgen"
+)
+
+func runGenModels(args []string) error {
+	fs := flag.NewFlagSet("genmodels", flag.ExitOnError)
+	n := fs.Int("n", 300, "生成的模型个数")
+	pkg := fs.String("pkg", "", "生成文件的包名，默认取 $GOPACKAGE (go generate 设置)")
+	seed := fs.Uint64("seed", 1, "决定每个模型字段个数与类型的种子")
+	out := fs.String("o", "models_gen.go", "输出文件，- 表示 stdout")
+	fs.Parse(args)
+
+	if *pkg == "" {
+		*pkg = os.Getenv("GOPACKAGE")
+	}
+	if *pkg == "" {
+		return fmt.Errorf("-pkg is required outside go generate")
+	}
+	var buf bytes.Buffer
+	err := modelgen.Generate(&buf, modelgen.Options{
+		Package: *pkg,
+		Count:   *n,
+		Seed:    *seed,
+		Command: fmt.Sprintf("jormbench genmodels -n %d -seed %d", *n, *seed),
+	})
+	if err != nil {
+		return err
+	}
+	if *out == "-" {
+		_, err := os.Stdout.Write(buf.Bytes())
+		return err
+	}
+	if err := os.WriteFile(*out, buf.Bytes(), 0o644); err != nil {
+		return err
+	}
+	log.Printf("wrote %d models to %s", *n, *out)
+	return nil
+}
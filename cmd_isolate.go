@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"strings"
+
+	"goapi/config"
+	"goapi/report"
+	"goapi/runner"
+)
+
+func runIsolate(args []string) error {
+	fs := flag.NewFlagSet("isolate", flag.ExitOnError)
+	bench := fs.String("bench", "", "只运行名称匹配该正则的 benchmark")
+	pkgs := fs.String("pkgs", strings.Join(runner.DefaultPackages, ","), "benchmark 包，逗号分隔")
+	count := fs.Int("count", 5, "每个 benchmark 的重复次数，各轮交错执行")
+	benchtime := fs.String("benchtime", "", "go test -benchtime")
+	seed := fs.Uint64("seed", 0, "执行顺序的随机数种子，0 表示随机")
+	out := fs.String("out", "", "把结果保存为基线 JSON")
+	fs.Parse(args)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	set, err := runner.Isolated(ctx, runner.IsolateOptions{
+		Options: runner.Options{
+			Packages:  config.SplitList(*pkgs),
+			Bench:     *bench,
+			Count:     *count,
+			Benchtime: *benchtime,
+		},
+		Seed: *seed,
+		Log:  os.Stderr,
+	})
+	if err != nil {
+		return err
+	}
+	if err := report.WriteText(os.Stdout, set); err != nil {
+		return err
+	}
+	if *out != "" {
+		return report.NewBaseline(set).Save(*out)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"goapi/config"
+	"goapi/envinfo"
+	"goapi/histogram"
+	"goapi/report"
+	"goapi/scenario"
+)
+
+func runLatency(args []string) error {
+	fs := flag.NewFlagSet("latency", flag.ExitOnError)
+	cfgPath := fs.String("config", "", "配置文件，默认查找 "+config.FileName)
+	scenarios := fs.String("scenarios", "", "场景，逗号分隔，默认全部: "+scenarioNames())
+	orms := fs.String("orms", strings.Join(scenario.ORMs, ","), "ORM，逗号分隔")
+	rows := fs.Int("rows", 0, "预置数据量，默认使用每个场景自己的数据量")
+	storage := fs.String("storage", scenario.StorageFile, "SQLite 存储模式: "+strings.Join(scenario.Storages, ", "))
+	db := fs.String("db", defaultDB, "SQLite 数据库文件")
+	ops := fs.Int("ops", 10000, "每个组合记录的操作次数")
+	duration := fs.Duration("duration", 0, "按时间运行，设置后忽略 -ops")
+	warmup := fs.Int("warmup", 100, "预热操作次数，不计入直方图")
+	hist := fs.String("hist", "", "保存直方图文件，可用 -merge 合并")
+	merge := fs.String("merge", "", "不运行场景，合并逗号分隔的直方图文件并输出分位数表")
+	out := fs.String("out", "", "把结果保存为基线 JSON")
+	fs.Parse(args)
+
+	if *merge != "" {
+		return mergeHistograms(config.SplitList(*merge), *hist)
+	}
+
+	cfg, err := loadConfig(fs, *cfgPath, *db)
+	if err != nil {
+		return err
+	}
+	selected, err := scenario.Select(config.SplitList(*scenarios))
+	if err != nil {
+		return err
+	}
+	ormList := config.SplitList(*orms)
+	for _, o := range ormList {
+		if !slices.Contains(scenario.ORMs, o) {
+			return fmt.Errorf("unknown orm %q (want %s)", o, strings.Join(scenario.ORMs, ", "))
+		}
+	}
+	target, err := scenario.NewTarget(cfg, *storage, defaultDB)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	opts := scenario.LatencyOptions{Ops: *ops, Duration: *duration, Warmup: *warmup}
+	env := envinfo.Collect(target.Mode())
+	results := &report.Set{Config: env}
+	file := &histogram.File{CreatedAt: time.Now(), Config: env}
+	report.WriteText(os.Stdout, results)
+	fmt.Println("pkg: jormbench")
+	for _, s := range selected {
+		for _, orm := range ormList {
+			r, h, err := scenario.Latency(target, orm, s, *rows, opts)
+			if err != nil {
+				return err
+			}
+			r.Package = "jormbench"
+			results.Results = append(results.Results, r)
+			file.Entries = append(file.Entries, histogram.Entry{Name: r.Name, ORM: orm, Scenario: r.Scenario, Histogram: h})
+			fmt.Println(report.FormatLine(r))
+		}
+	}
+
+	// 分位数表写到标准错误，标准输出保持 go test -bench 格式
+	fmt.Fprintln(os.Stderr)
+	if err := histogram.WriteTable(os.Stderr, file.Entries); err != nil {
+		return err
+	}
+	if *hist != "" {
+		if err := file.Save(*hist); err != nil {
+			return err
+		}
+	}
+	if *out != "" {
+		return report.NewBaseline(results).Save(*out)
+	}
+	return nil
+}
+
+// mergeHistograms 合并多个直方图文件，输出分位数表，out 非空时保存合并结果
+func mergeHistograms(paths []string, out string) error {
+	files := make([]*histogram.File, 0, len(paths))
+	for _, p := range paths {
+		f, err := histogram.Load(p)
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+	}
+	merged := histogram.Merge(files...)
+	if err := histogram.WriteTable(os.Stdout, merged.Entries); err != nil {
+		return err
+	}
+	if out != "" {
+		return merged.Save(out)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+
+	"goapi/config"
+	"goapi/profdiff"
+	"goapi/runner"
+)
+
+func runProfile(args []string) error {
+	fs := flag.NewFlagSet("profile", flag.ExitOnError)
+	dir := fs.String("dir", "profiles", "结果目录")
+	bench := fs.String("bench", "", "只采集名称匹配该正则的 benchmark")
+	pkgs := fs.String("pkgs", strings.Join(runner.DefaultPackages, ","), "benchmark 包，逗号分隔")
+	benchtime := fs.String("benchtime", "2s", "每个 benchmark 的 -benchtime，CPU profile 需要足够的运行时间")
+	top := fs.Int("top", 15, "每张差异表的行数")
+	reportOnly := fs.Bool("report-only", false, "不重新采集，直接根据结果目录中的清单生成报告")
+	fs.Parse(args)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var (
+		captures []profdiff.Capture
+		err      error
+	)
+	if *reportOnly {
+		captures, err = profdiff.LoadManifest(*dir)
+	} else {
+		captures, err = runner.CaptureProfiles(ctx, runner.ProfileOptions{
+			Options: runner.Options{
+				Packages:  config.SplitList(*pkgs),
+				Bench:     *bench,
+				Benchtime: *benchtime,
+			},
+			Dir: *dir,
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(filepath.Join(*dir, "diff.md"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return profdiff.WriteReport(io.MultiWriter(os.Stdout, f), *dir, captures, *top)
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"goapi/config"
+	"goapi/envinfo"
+	"goapi/histogram"
+	"goapi/report"
+	"goapi/scenario"
+)
+
+func runRate(args []string) error {
+	fs := flag.NewFlagSet("rate", flag.ExitOnError)
+	cfgPath := fs.String("config", "", "配置文件，默认查找 "+config.FileName)
+	scenarios := fs.String("scenarios", "Insert", "场景，逗号分隔: "+scenarioNames())
+	orms := fs.String("orms", strings.Join(scenario.ORMs, ","), "ORM，逗号分隔")
+	rows := fs.Int("rows", 0, "预置数据量，默认使用每个场景自己的数据量")
+	storage := fs.String("storage", scenario.StorageFile, "SQLite 存储模式: "+strings.Join(scenario.Storages, ", "))
+	db := fs.String("db", defaultDB, "SQLite 数据库文件")
+	rate := fs.Float64("rate", 2000, "目标速率 (ops/s)，逐级加压时为起始速率")
+	step := fs.Float64("step", 0, "每级增加的速率，大于 0 时逐级加压直到饱和")
+	maxRate := fs.Float64("max-rate", 100000, "逐级加压的速率上限")
+	duration := fs.Duration("duration", 5*time.Second, "每级的运行时间")
+	warmup := fs.Int("warmup", 100, "每级开始前的预热操作次数")
+	hist := fs.String("hist", "", "保存每级的延迟直方图文件")
+	out := fs.String("out", "", "把结果保存为基线 JSON")
+	fs.Parse(args)
+
+	if *rate > *maxRate {
+		return fmt.Errorf("-rate %g is above -max-rate %g", *rate, *maxRate)
+	}
+	cfg, err := loadConfig(fs, *cfgPath, *db)
+	if err != nil {
+		return err
+	}
+	selected, err := scenario.Select(config.SplitList(*scenarios))
+	if err != nil {
+		return err
+	}
+	ormList := config.SplitList(*orms)
+	for _, o := range ormList {
+		if !slices.Contains(scenario.ORMs, o) {
+			return fmt.Errorf("unknown orm %q (want %s)", o, strings.Join(scenario.ORMs, ", "))
+		}
+	}
+	target, err := scenario.NewTarget(cfg, *storage, defaultDB)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	env := envinfo.Collect(target.Mode())
+	results := &report.Set{Config: env}
+	file := &histogram.File{CreatedAt: time.Now(), Config: env}
+	report.WriteText(os.Stdout, results)
+	fmt.Println("pkg: jormbench")
+	var ramps []ramp
+	for _, s := range selected {
+		for _, orm := range ormList {
+			rp := ramp{scenario: s.Name, orm: orm}
+			for r := *rate; r <= *maxRate; r += *step {
+				run, err := scenario.OpenLoop(target, orm, s, *rows, scenario.RateOptions{Rate: r, Duration: *duration, Warmup: *warmup})
+				if err != nil {
+					return err
+				}
+				run.Result.Package = "jormbench"
+				results.Results = append(results.Results, run.Result)
+				file.Entries = append(file.Entries, histogram.Entry{
+					Name: run.Result.Name, ORM: orm, Scenario: run.Result.Scenario, Histogram: run.Latency,
+				})
+				fmt.Println(report.FormatLine(run.Result))
+				rp.runs = append(rp.runs, run)
+				if *step <= 0 || run.Saturated() {
+					break
+				}
+			}
+			ramps = append(ramps, rp)
+		}
+	}
+
+	// 饱和点汇总写到标准错误，标准输出保持 go test -bench 格式
+	fmt.Fprintln(os.Stderr)
+	if err := writeRamps(os.Stderr, ramps); err != nil {
+		return err
+	}
+	if *hist != "" {
+		if err := file.Save(*hist); err != nil {
+			return err
+		}
+	}
+	if *out != "" {
+		return report.NewBaseline(results).Save(*out)
+	}
+	return nil
+}
+
+// ramp 是一个场景、一个 ORM 逐级加压的全部档位
+type ramp struct {
+	scenario, orm string
+	runs          []*scenario.RateRun
+}
+
+// writeRamps 输出每个 ORM 能维持的最高速率 (饱和点) 及该速率下的延迟
+func writeRamps(w io.Writer, ramps []ramp) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "| 操作类型 | ORM 框架 | 可维持速率 (ops/s) | p50 (µs) | p99 (µs) | p999 (µs) | 饱和速率 (ops/s) | 饱和时实际吞吐 |")
+	fmt.Fprintln(bw, "|---------|---------|------------------|---------|---------|----------|----------------|--------------|")
+	for _, rp := range ramps {
+		sustained, saturated := "-", "-"
+		p50, p99, p999, achieved := "-", "-", "-", "-"
+		for _, run := range rp.runs {
+			if run.Saturated() {
+				saturated = report.FormatInt(run.Target)
+				achieved = report.FormatInt(run.Achieved)
+				break
+			}
+			sustained = report.FormatInt(run.Target)
+			p50 = histogram.Micros(float64(run.Latency.Quantile(0.50)))
+			p99 = histogram.Micros(float64(run.Latency.Quantile(0.99)))
+			p999 = histogram.Micros(float64(run.Latency.Quantile(0.999)))
+		}
+		fmt.Fprintf(bw, "| %s | %s | %s | %s | %s | %s | %s | %s |\n",
+			report.ScenarioLabel(rp.scenario), strings.ToUpper(rp.orm), sustained, p50, p99, p999, saturated, achieved)
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"goapi/report"
+)
+
+func runReport(args []string) error {
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	in := fs.String("in", "", "结果文件 (基线 JSON、go test -bench 文本或 go test -json)，默认读取标准输入")
+	readme := fs.String("readme", "", "直接替换该 markdown 文件中 bench:begin / bench:end 标记之间的表格")
+	save := fs.String("save", "", "同时把结果保存为基线 JSON")
+	toggle := fs.String("toggle", "", "额外输出 <key>=off / <key>=on 两种设置的对比表，例如 stmt")
+	alpha := fs.Float64("alpha", report.DefaultAlpha, "多次运行 (-count) 时 Mann-Whitney U 检验的显著性水平")
+	maxCV := fs.Float64("max-cv", report.DefaultMaxCV, "ns/op 变异系数上限，超过时给出噪声警告")
+	strict := fs.Bool("strict", false, "有噪声警告时不生成报告，以非 0 状态码退出")
+	fs.Parse(args)
+
+	b, err := loadResults(*in)
+	if err != nil {
+		return err
+	}
+	if *save != "" {
+		if err := b.Save(*save); err != nil {
+			return fmt.Errorf("save baseline: %w", err)
+		}
+		log.Printf("saved %d results for jorm %s to %s", len(b.Results), b.JormVersion, *save)
+	}
+
+	table := report.Group(b.Results)
+	table.Alpha = *alpha
+	table.MaxCV = *maxCV
+	table.Env = b.Config
+	if err := checkNoise(table, *maxCV, *strict); err != nil {
+		return err
+	}
+	if *readme != "" {
+		return report.WriteReadme(*readme, table)
+	}
+	if err := report.WriteMarkdown(os.Stdout, table); err != nil {
+		return err
+	}
+	if *toggle == "" {
+		return nil
+	}
+	fmt.Println()
+	return report.WriteToggle(os.Stdout, table, *toggle)
+}
+
+// loadResults 读取结果文件或标准输入；来自 benchmark 输出时按头部的 jorm 行标记版本，见 report.NewBaseline
+func loadResults(path string) (*report.Baseline, error) {
+	var b *report.Baseline
+	if path != "" {
+		loaded, err := report.Load(path)
+		if err != nil {
+			return nil, err
+		}
+		b = loaded
+		if b.JormVersion == "" {
+			b = report.NewBaseline(&report.Set{Config: loaded.Config, Results: loaded.Results})
+		}
+	} else {
+		set, err := report.Parse(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("parse benchmark output: %w", err)
+		}
+		b = report.NewBaseline(set)
+	}
+	if len(b.Results) == 0 {
+		return nil, fmt.Errorf("no benchmark results found")
+	}
+	return b, nil
+}
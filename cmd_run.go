@@ -0,0 +1,181 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+
+	"goapi/config"
+	"goapi/envinfo"
+	"goapi/report"
+	"goapi/scenario"
+)
+
+// defaultDB 是 run / seed 使用的 SQLite 文件，与 benchmark 包中的 test.db 分开
+const defaultDB = "jormbench.db"
+
+func runRun(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	cfgPath := fs.String("config", "", "配置文件，默认查找 "+config.FileName)
+	scenarios := fs.String("scenarios", "", "场景，逗号分隔，默认全部: "+scenarioNames())
+	orms := fs.String("orms", strings.Join(scenario.ORMs, ","), "ORM，逗号分隔")
+	sizes := fs.String("sizes", "", "预置数据量，逗号分隔，默认使用每个场景自己的数据量")
+	storages := fs.String("storage", scenario.StorageFile, "SQLite 存储模式，逗号分隔: "+strings.Join(scenario.Storages, ", "))
+	db := fs.String("db", defaultDB, "SQLite 数据库文件 (file / wal 模式)")
+	benchtime := fs.Duration("benchtime", 0, "每个场景的目标运行时间，默认 1s")
+	iterations := fs.Int("n", 0, "固定迭代次数，设置后忽略 -benchtime")
+	count := fs.Int("count", 1, "每个组合的运行次数，多次运行时 report / compare 会做显著性检验")
+	out := fs.String("out", "", "把结果保存为基线 JSON，可直接交给 report / compare")
+	warmup := fs.Int("warmup", 100, "每轮计时前不计时的预热操作次数")
+	maxCV := fs.Float64("max-cv", report.DefaultMaxCV, "ns/op 变异系数上限，超过时给出噪声警告")
+	strict := fs.Bool("strict", false, "环境检查或噪声检查有警告时拒绝运行 / 以非 0 状态码退出")
+	fs.Parse(args)
+	if err := checkEnv(*strict); err != nil {
+		return err
+	}
+
+	// 命令行未指定的参数使用配置文件中 bench 段的值
+	cfg, err := loadConfig(fs, *cfgPath, *db)
+	if err != nil {
+		return err
+	}
+	set := setFlags(fs)
+	if !set["scenarios"] && len(cfg.Bench.Scenarios) > 0 {
+		*scenarios = strings.Join(cfg.Bench.Scenarios, ",")
+	}
+	if !set["orms"] && len(cfg.Bench.ORMs) > 0 {
+		*orms = strings.Join(cfg.Bench.ORMs, ",")
+	}
+	if !set["storage"] && len(cfg.Bench.Storage) > 0 {
+		*storages = strings.Join(cfg.Bench.Storage, ",")
+	}
+	if !set["benchtime"] {
+		*benchtime = cfg.Bench.Benchtime
+	}
+	if !set["count"] && cfg.Bench.Count > 0 {
+		*count = cfg.Bench.Count
+	}
+	rowList := cfg.Bench.Sizes
+	if set["sizes"] {
+		if rowList, err = config.ParseSizes(*sizes); err != nil {
+			return err
+		}
+	}
+	if len(rowList) == 0 {
+		// 0 表示使用场景自己的数据量
+		rowList = []int{0}
+	}
+
+	selected, err := scenario.Select(config.SplitList(*scenarios))
+	if err != nil {
+		return err
+	}
+	ormList := config.SplitList(*orms)
+	for _, o := range ormList {
+		if !slices.Contains(scenario.ORMs, o) {
+			return fmt.Errorf("unknown orm %q (want %s)", o, strings.Join(scenario.ORMs, ", "))
+		}
+	}
+	opts := scenario.Options{Benchtime: *benchtime, Iterations: *iterations, Warmup: *warmup}
+
+	storageList := config.SplitList(*storages)
+	results := &report.Set{Config: envinfo.Collect(envinfo.Mode(cfg.Database.Driver, storageList...))}
+	// 边运行边输出，格式与 go test -bench 相同，可以直接管道给 report
+	report.WriteText(os.Stdout, results)
+	fmt.Println("pkg: jormbench")
+	for _, storage := range storageList {
+		target, err := scenario.NewTarget(cfg, storage, defaultDB)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < *count; i++ {
+			for _, s := range selected {
+				for _, rows := range rowList {
+					for _, orm := range ormList {
+						r, err := scenario.Run(target, orm, s, rows, opts)
+						if err != nil {
+							target.Close()
+							return err
+						}
+						r.Package = "jormbench"
+						results.Results = append(results.Results, r)
+						fmt.Println(report.FormatLine(r))
+					}
+				}
+			}
+		}
+		if err := target.Close(); err != nil {
+			return err
+		}
+	}
+
+	if *out != "" {
+		if err := report.NewBaseline(results).Save(*out); err != nil {
+			return err
+		}
+	}
+	return checkNoise(report.Group(results.Results), *maxCV, *strict)
+}
+
+// checkEnv 输出 CPU 调频、睿频、系统负载等环境警告，strict 时有警告即拒绝运行
+func checkEnv(strict bool) error {
+	warns := envinfo.Check()
+	for _, w := range warns {
+		log.Printf("warning: %s", w)
+	}
+	if strict && len(warns) > 0 {
+		return fmt.Errorf("refusing to run in strict mode: %d environment warnings", len(warns))
+	}
+	return nil
+}
+
+// checkNoise 输出不稳定或差异小于噪声的分组，strict 时有警告即返回错误
+func checkNoise(t *report.Table, maxCV float64, strict bool) error {
+	warns := report.CheckNoise(t, maxCV)
+	for _, w := range warns {
+		log.Printf("warning: %s", w)
+	}
+	if strict && len(warns) > 0 {
+		return fmt.Errorf("strict mode: %d noise warnings", len(warns))
+	}
+	return nil
+}
+
+// loadConfig 读取 -config 指定的文件 (否则按 config.LoadDefault 查找)，
+// 显式传入的 -db 覆盖配置中的 SQLite 路径
+func loadConfig(fs *flag.FlagSet, path, db string) (*config.Config, error) {
+	var (
+		cfg *config.Config
+		err error
+	)
+	if path != "" {
+		cfg, err = config.Load(path)
+	} else {
+		cfg, err = config.LoadDefault()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+	if setFlags(fs)["db"] {
+		cfg.Database.Path = db
+	}
+	return cfg, nil
+}
+
+// setFlags 返回命令行中显式设置过的参数
+func setFlags(fs *flag.FlagSet) map[string]bool {
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	return set
+}
+
+func scenarioNames() string {
+	names := make([]string, len(scenario.All))
+	for i, s := range scenario.All {
+		names[i] = s.Name
+	}
+	return strings.Join(names, ", ")
+}
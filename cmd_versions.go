@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+
+	"goapi/config"
+	"goapi/report"
+	"goapi/runner"
+)
+
+func runVersions(args []string) error {
+	fs := flag.NewFlagSet("versions", flag.ExitOnError)
+	bench := fs.String("bench", ".", "go test -bench 正则")
+	count := fs.Int("count", 5, "go test -count")
+	benchtime := fs.String("benchtime", "", "go test -benchtime")
+	pkgs := fs.String("pkgs", strings.Join(runner.DefaultPackages, ","), "benchmark 包，逗号分隔")
+	offline := fs.Bool("offline", false, "只使用模块缓存中已有的版本 (GOPROXY=off)")
+	alpha := fs.Float64("alpha", report.DefaultAlpha, "Mann-Whitney U 检验的显著性水平")
+	save := fs.String("save", "", "把每个版本的结果保存为 <dir>/<label>.json 基线文件")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: jormbench versions [flags] <version|dir|label=version|label=dir> ... (at least two)")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() < 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	var targets []runner.Target
+	for _, arg := range fs.Args() {
+		t, err := runner.ParseTarget(arg)
+		if err != nil {
+			return fmt.Errorf("parse target: %w", err)
+		}
+		targets = append(targets, t)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	opts := runner.VersionOptions{
+		Options: runner.Options{
+			Packages:  config.SplitList(*pkgs),
+			Bench:     *bench,
+			Count:     *count,
+			Benchtime: *benchtime,
+			Stderr:    os.Stderr,
+		},
+		Offline: *offline,
+		Log:     os.Stderr,
+	}
+	runs, err := runner.RunVersions(ctx, opts, targets)
+	if err != nil {
+		return fmt.Errorf("run versions: %w", err)
+	}
+
+	series := make([]report.Series, 0, len(runs))
+	for _, r := range runs {
+		series = append(series, report.Series{Label: r.Target.Label, Results: r.Set.Results})
+		if *save == "" {
+			continue
+		}
+		b := report.NewBaseline(r.Set)
+		b.JormVersion = r.Target.Label
+		if r.Target.Version != "" {
+			b.JormVersion = r.Target.Version
+		}
+		if err := os.MkdirAll(*save, 0o755); err != nil {
+			return err
+		}
+		if err := b.Save(filepath.Join(*save, r.Target.Label+".json")); err != nil {
+			return fmt.Errorf("save %s: %w", r.Target.Label, err)
+		}
+	}
+	return report.WriteSeries(os.Stdout, series, *alpha)
+}
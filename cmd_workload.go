@@ -0,0 +1,87 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"goapi/config"
+	"goapi/envinfo"
+	"goapi/histogram"
+	"goapi/report"
+	"goapi/scenario"
+	"goapi/workload"
+)
+
+func runWorkload(args []string) error {
+	fs := flag.NewFlagSet("workload", flag.ExitOnError)
+	cfgPath := fs.String("config", "", "配置文件，默认查找 "+config.FileName)
+	names := fs.String("workloads", "A,B,C,D,E,F", "YCSB 负载，逗号分隔")
+	orms := fs.String("orms", strings.Join(scenario.ORMs, ","), "ORM，逗号分隔")
+	dist := fs.String("dist", "", "键分布，覆盖负载默认值: "+strings.Join(workload.Distributions, ", "))
+	records := fs.Int("records", 1000, "预置记录数")
+	ops := fs.Int("ops", 10000, "每个负载的操作次数")
+	duration := fs.Duration("duration", 0, "按时间运行，设置后忽略 -ops")
+	storage := fs.String("storage", scenario.StorageFile, "SQLite 存储模式: "+strings.Join(scenario.Storages, ", "))
+	db := fs.String("db", defaultDB, "SQLite 数据库文件")
+	seed := fs.Uint64("seed", 1, "随机数种子，各 ORM 使用相同的操作序列")
+	hist := fs.String("hist", "", "保存每种操作的延迟直方图文件，可用 latency -merge 合并")
+	out := fs.String("out", "", "把结果保存为基线 JSON")
+	fs.Parse(args)
+
+	cfg, err := loadConfig(fs, *cfgPath, *db)
+	if err != nil {
+		return err
+	}
+	var selected []workload.Workload
+	for _, name := range config.SplitList(*names) {
+		w, ok := workload.Lookup(name)
+		if !ok {
+			return fmt.Errorf("unknown workload %q", name)
+		}
+		selected = append(selected, w)
+	}
+	target, err := scenario.NewTarget(cfg, *storage, defaultDB)
+	if err != nil {
+		return err
+	}
+	defer target.Close()
+
+	opts := workload.Options{Records: *records, Ops: *ops, Duration: *duration, Dist: *dist, Seed: *seed}
+	results := &report.Set{Config: envinfo.Collect(target.Mode())}
+	report.WriteText(os.Stdout, results)
+	fmt.Println("pkg: jormbench")
+	var runs []*workload.Run
+	for _, w := range selected {
+		for _, orm := range config.SplitList(*orms) {
+			run, err := workload.Execute(target, orm, w, opts)
+			if err != nil {
+				return err
+			}
+			runs = append(runs, run)
+			results.Results = append(results.Results, run.Result)
+			fmt.Println(report.FormatLine(run.Result))
+		}
+	}
+
+	// 延迟表写到标准错误，标准输出保持 go test -bench 格式，可以管道给 report
+	fmt.Fprintln(os.Stderr)
+	if err := workload.WriteSummary(os.Stderr, runs); err != nil {
+		return err
+	}
+	if *hist != "" {
+		file := &histogram.File{CreatedAt: time.Now(), Config: results.Config}
+		for _, run := range runs {
+			file.Entries = append(file.Entries, run.Histograms()...)
+		}
+		if err := file.Save(*hist); err != nil {
+			return err
+		}
+	}
+	if *out != "" {
+		return report.NewBaseline(results).Save(*out)
+	}
+	return nil
+}
@@ -0,0 +1,359 @@
+package coldstart_bench
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"goapi/sqlcount/sqlcounttest"
+	xormlog "xorm.io/xorm/log"
+)
+
+// setupTestData 在每个 benchmark 开始前准备测试数据
+func setupTestData(b *testing.B, count int) {
+	b.Helper()
+	t, err := benchTarget()
+	if err != nil {
+		b.Fatalf("load config: %v", err)
+	}
+	// 清空表、重置自增 ID 并插入测试数据，按配置的驱动执行对应的 SQL
+	if err := t.Seed(count); err != nil {
+		b.Fatalf("seed users: %v", err)
+	}
+}
+
+// probeNext 记录每个 ORM 已经用过的 probes 个数，go test 重复运行时也不会重复使用
+var probeNext = map[string]int{}
+
+// nextProbes 返回 orm 还没用过的 n 个模型，模型池不够时跳过 benchmark
+func nextProbes(b *testing.B, orm string, n int) []func() any {
+	b.Helper()
+	i := probeNext[orm]
+	if i+n > len(probes) {
+		b.Skipf("%s: only %d unused model types left, run with -count=%d or less", orm, len(probes)-i, len(probes)/firstModelBatch)
+	}
+	probeNext[orm] = i + n
+	return probes[i : i+n]
+}
+
+// firstModelBatch 是每次测量首次使用开销的新模型个数
+const firstModelBatch = 8
+
+// firstUse 是一批新模型第一次查询的平均开销
+type firstUse struct {
+	ns, bytes, allocs float64
+}
+
+// firstUseStats 保存每个 ORM 最近一次测量的结果
+var firstUseStats = map[string]firstUse{}
+
+// measureFirstModels 在 go test 每次重复的第一轮 (b.N == 1，尚未输出结果行) 对 firstModelBatch 个新模型各执行一次 query，
+// 返回的函数在计时结束后上报每个模型的平均开销 ns/model、B/model、allocs/model (ResetTimer 会清除此前上报的指标)；
+// 按 b.N 加大迭代次数的后续各轮沿用这次测量，使用的模型数与 b.N 无关
+func measureFirstModels(b *testing.B, orm string, query func(m any, i int) error) func() {
+	b.Helper()
+	if b.N == 1 {
+		models := nextProbes(b, orm, firstModelBatch)
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		for i, newModel := range models {
+			if err := query(newModel(), i); err != nil {
+				b.Fatalf("%s first model: %v", orm, err)
+			}
+		}
+		elapsed := time.Since(start)
+		runtime.ReadMemStats(&after)
+		firstUseStats[orm] = firstUse{
+			ns:     float64(elapsed.Nanoseconds()) / firstModelBatch,
+			bytes:  float64(after.TotalAlloc-before.TotalAlloc) / firstModelBatch,
+			allocs: float64(after.Mallocs-before.Mallocs) / firstModelBatch,
+		}
+	}
+	s := firstUseStats[orm]
+	return func() {
+		b.ReportMetric(s.ns, "ns/model")
+		b.ReportMetric(s.bytes, "B/model")
+		b.ReportMetric(s.allocs, "allocs/model")
+	}
+}
+
+// BenchmarkJormOpen 测试 jorm.Open：创建引擎并建立第一个连接 (jorm.Open 内部会 Ping)
+func BenchmarkJormOpen(b *testing.B) {
+	setupTestData(b, 1000)
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		engine, err := NewJormEngine()
+		if err != nil {
+			b.Fatalf("new jorm engine: %v", err)
+		}
+		b.StopTimer()
+		engine.Close()
+		b.StartTimer()
+	}
+}
+
+// BenchmarkGormOpen 测试 gorm.Open：创建 DB 并建立第一个连接 (gorm.Open 默认会 Ping)
+func BenchmarkGormOpen(b *testing.B) {
+	setupTestData(b, 1000)
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		db, err := NewGormDB()
+		if err != nil {
+			b.Fatalf("new gorm db: %v", err)
+		}
+		b.StopTimer()
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+		b.StartTimer()
+	}
+}
+
+// BenchmarkXormOpen 测试 xorm.NewEngine：NewEngine 不建立连接，因此再 Ping 一次与另外两种 ORM 对齐
+// (engine.Ping 总是输出一行日志，这里直接 Ping 底层连接池)
+func BenchmarkXormOpen(b *testing.B) {
+	setupTestData(b, 1000)
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		engine, err := NewXormEngine()
+		if err != nil {
+			b.Fatalf("new xorm engine: %v", err)
+		}
+		if err := engine.DB().Ping(); err != nil {
+			b.Fatalf("xorm ping: %v", err)
+		}
+		b.StopTimer()
+		engine.Close()
+		b.StartTimer()
+	}
+}
+
+// BenchmarkJormFirstQuery 测试新打开的 jorm 引擎上的第一次按 ID 查询，包括建立连接与模型解析。
+// jorm 的模型缓存是进程级的，第二次迭代起已经命中缓存，进程级的冷启动见 readme 中的 isolate 用法
+func BenchmarkJormFirstQuery(b *testing.B) {
+	setupTestData(b, 1000)
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		engine, err := NewJormEngine()
+		if err != nil {
+			b.Fatalf("new jorm engine: %v", err)
+		}
+		var user User
+		if err := engine.Model(&user).Where("id = ?", int64(i%1000+1)).First(&user); err != nil {
+			b.Fatalf("jorm find: %v", err)
+		}
+		b.StopTimer()
+		engine.Close()
+		b.StartTimer()
+	}
+}
+
+// BenchmarkGormFirstQuery 测试新打开的 gorm DB 上的第一次按 ID 查询，gorm 的 schema 缓存属于每个 DB
+func BenchmarkGormFirstQuery(b *testing.B) {
+	setupTestData(b, 1000)
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		db, err := NewGormDB()
+		if err != nil {
+			b.Fatalf("new gorm db: %v", err)
+		}
+		var user User
+		if err := db.Where("id = ?", int64(i%1000+1)).First(&user).Error; err != nil {
+			b.Fatalf("gorm find: %v", err)
+		}
+		b.StopTimer()
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+		b.StartTimer()
+	}
+}
+
+// BenchmarkXormFirstQuery 测试新打开的 xorm 引擎上的第一次按 ID 查询，xorm 的表信息缓存属于每个引擎
+func BenchmarkXormFirstQuery(b *testing.B) {
+	setupTestData(b, 1000)
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		engine, err := NewXormEngine()
+		if err != nil {
+			b.Fatalf("new xorm engine: %v", err)
+		}
+		var user User
+		has, err := engine.ID(int64(i%1000 + 1)).Get(&user)
+		if err != nil {
+			b.Fatalf("xorm find: %v", err)
+		}
+		if !has {
+			b.Fatalf("xorm find: user not found")
+		}
+		b.StopTimer()
+		engine.Close()
+		b.StartTimer()
+	}
+}
+
+// BenchmarkJormFirstModel 测试已经建立连接的 jorm 引擎第一次遇到某个模型类型时的查询，见 measureFirstModels；
+// 计时循环重复查询已缓存的 User，ns/op 与 ns/model 之差即首次解析模型的开销
+func BenchmarkJormFirstModel(b *testing.B) {
+	setupTestData(b, 1000)
+
+	engine, err := NewJormEngine()
+	if err != nil {
+		b.Fatalf("new jorm engine: %v", err)
+	}
+	defer engine.Close()
+	query := func(m any, i int) error {
+		return engine.Model(m).Where("id = ?", int64(i%1000+1)).First(m)
+	}
+	if err := query(&User{}, 0); err != nil {
+		b.Fatalf("jorm warm up: %v", err)
+	}
+	defer measureFirstModels(b, "jorm", query)()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		if err := query(&User{}, i); err != nil {
+			b.Fatalf("jorm find: %v", err)
+		}
+	}
+}
+
+// BenchmarkGormFirstModel 测试已经建立连接的 gorm DB 第一次遇到某个模型类型时的查询
+func BenchmarkGormFirstModel(b *testing.B) {
+	setupTestData(b, 1000)
+
+	db, err := NewGormDB()
+	if err != nil {
+		b.Fatalf("new gorm db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("gorm db.DB(): %v", err)
+	}
+	defer sqlDB.Close()
+	query := func(m any, i int) error {
+		return db.Where("id = ?", int64(i%1000+1)).First(m).Error
+	}
+	if err := query(&User{}, 0); err != nil {
+		b.Fatalf("gorm warm up: %v", err)
+	}
+	defer measureFirstModels(b, "gorm", query)()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		if err := query(&User{}, i); err != nil {
+			b.Fatalf("gorm find: %v", err)
+		}
+	}
+}
+
+// BenchmarkXormFirstModel 测试已经建立连接的 xorm 引擎第一次遇到某个模型类型时的查询
+func BenchmarkXormFirstModel(b *testing.B) {
+	setupTestData(b, 1000)
+
+	engine, err := NewXormEngine()
+	if err != nil {
+		b.Fatalf("new xorm engine: %v", err)
+	}
+	defer engine.Close()
+	query := func(m any, i int) error {
+		has, err := engine.ID(int64(i%1000 + 1)).Get(m)
+		if err == nil && !has {
+			err = fmt.Errorf("user %d not found", i%1000+1)
+		}
+		return err
+	}
+	if err := query(&User{}, 0); err != nil {
+		b.Fatalf("xorm warm up: %v", err)
+	}
+	defer measureFirstModels(b, "xorm", query)()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		if err := query(&User{}, i); err != nil {
+			b.Fatalf("xorm find: %v", err)
+		}
+	}
+}
+
+// BenchmarkJormOpenAutoMigrate 测试短生命周期进程的启动路径：jorm.Open 后对已有 1000 行数据的表执行 AutoMigrate
+func BenchmarkJormOpenAutoMigrate(b *testing.B) {
+	setupTestData(b, 1000)
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		engine, err := NewJormEngine()
+		if err != nil {
+			b.Fatalf("new jorm engine: %v", err)
+		}
+		if err := engine.AutoMigrate(&User{}); err != nil {
+			b.Fatalf("jorm auto migrate: %v", err)
+		}
+		b.StopTimer()
+		engine.Close()
+		b.StartTimer()
+	}
+}
+
+// BenchmarkGormOpenAutoMigrate 测试 gorm.Open 后对已有表执行 AutoMigrate
+func BenchmarkGormOpenAutoMigrate(b *testing.B) {
+	setupTestData(b, 1000)
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		db, err := NewGormDB()
+		if err != nil {
+			b.Fatalf("new gorm db: %v", err)
+		}
+		if err := db.AutoMigrate(&User{}); err != nil {
+			b.Fatalf("gorm auto migrate: %v", err)
+		}
+		b.StopTimer()
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+		b.StartTimer()
+	}
+}
+
+// BenchmarkXormOpenAutoMigrate 测试 xorm.NewEngine 后对已有表执行 Sync2。
+// create_table.sql 的列是 NOT NULL 而模型没有声明，Sync2 每次都会输出可空性不一致的警告，这里只保留错误日志
+func BenchmarkXormOpenAutoMigrate(b *testing.B) {
+	setupTestData(b, 1000)
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		engine, err := NewXormEngine()
+		if err != nil {
+			b.Fatalf("new xorm engine: %v", err)
+		}
+		engine.SetLogLevel(xormlog.LOG_ERR)
+		if err := engine.Sync2(new(User)); err != nil {
+			b.Fatalf("xorm sync: %v", err)
+		}
+		b.StopTimer()
+		engine.Close()
+		b.StartTimer()
+	}
+}
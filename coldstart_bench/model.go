@@ -0,0 +1,96 @@
+package coldstart_bench
+
+// User 用于三种 ORM 统一对比的模型
+// 注意：struct tag 同时包含 jorm / gorm / xorm 的配置
+
+type User struct {
+	ID   int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Name string `jorm:"column:username" gorm:"column:username" xorm:"'username'"`
+	Age  int    `jorm:"column:age" gorm:"column:age" xorm:"'age'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (User) TableName() string {
+	return "users"
+}
+
+// probe 与 User 字段相同，每个类型参数都得到一个新的具名类型，
+// 三种 ORM 都没有解析过它的元数据，用于测量模型第一次出现时的开销
+type probe[T any] struct {
+	ID   int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Name string `jorm:"column:username" gorm:"column:username" xorm:"'username'"`
+	Age  int    `jorm:"column:age" gorm:"column:age" xorm:"'age'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (probe[T]) TableName() string {
+	return "users"
+}
+
+// probes 是 FirstModel 依次使用的新模型，每个 ORM 各用一遍，每次重复用 firstModelBatch 个
+var probes = []func() any{
+	func() any { return new(probe[[0]byte]) },
+	func() any { return new(probe[[1]byte]) },
+	func() any { return new(probe[[2]byte]) },
+	func() any { return new(probe[[3]byte]) },
+	func() any { return new(probe[[4]byte]) },
+	func() any { return new(probe[[5]byte]) },
+	func() any { return new(probe[[6]byte]) },
+	func() any { return new(probe[[7]byte]) },
+	func() any { return new(probe[[8]byte]) },
+	func() any { return new(probe[[9]byte]) },
+	func() any { return new(probe[[10]byte]) },
+	func() any { return new(probe[[11]byte]) },
+	func() any { return new(probe[[12]byte]) },
+	func() any { return new(probe[[13]byte]) },
+	func() any { return new(probe[[14]byte]) },
+	func() any { return new(probe[[15]byte]) },
+	func() any { return new(probe[[16]byte]) },
+	func() any { return new(probe[[17]byte]) },
+	func() any { return new(probe[[18]byte]) },
+	func() any { return new(probe[[19]byte]) },
+	func() any { return new(probe[[20]byte]) },
+	func() any { return new(probe[[21]byte]) },
+	func() any { return new(probe[[22]byte]) },
+	func() any { return new(probe[[23]byte]) },
+	func() any { return new(probe[[24]byte]) },
+	func() any { return new(probe[[25]byte]) },
+	func() any { return new(probe[[26]byte]) },
+	func() any { return new(probe[[27]byte]) },
+	func() any { return new(probe[[28]byte]) },
+	func() any { return new(probe[[29]byte]) },
+	func() any { return new(probe[[30]byte]) },
+	func() any { return new(probe[[31]byte]) },
+	func() any { return new(probe[[32]byte]) },
+	func() any { return new(probe[[33]byte]) },
+	func() any { return new(probe[[34]byte]) },
+	func() any { return new(probe[[35]byte]) },
+	func() any { return new(probe[[36]byte]) },
+	func() any { return new(probe[[37]byte]) },
+	func() any { return new(probe[[38]byte]) },
+	func() any { return new(probe[[39]byte]) },
+	func() any { return new(probe[[40]byte]) },
+	func() any { return new(probe[[41]byte]) },
+	func() any { return new(probe[[42]byte]) },
+	func() any { return new(probe[[43]byte]) },
+	func() any { return new(probe[[44]byte]) },
+	func() any { return new(probe[[45]byte]) },
+	func() any { return new(probe[[46]byte]) },
+	func() any { return new(probe[[47]byte]) },
+	func() any { return new(probe[[48]byte]) },
+	func() any { return new(probe[[49]byte]) },
+	func() any { return new(probe[[50]byte]) },
+	func() any { return new(probe[[51]byte]) },
+	func() any { return new(probe[[52]byte]) },
+	func() any { return new(probe[[53]byte]) },
+	func() any { return new(probe[[54]byte]) },
+	func() any { return new(probe[[55]byte]) },
+	func() any { return new(probe[[56]byte]) },
+	func() any { return new(probe[[57]byte]) },
+	func() any { return new(probe[[58]byte]) },
+	func() any { return new(probe[[59]byte]) },
+	func() any { return new(probe[[60]byte]) },
+	func() any { return new(probe[[61]byte]) },
+	func() any { return new(probe[[62]byte]) },
+	func() any { return new(probe[[63]byte]) },
+}
@@ -0,0 +1,292 @@
+// Package config 读取 jormbench.yaml：database 段与 mysql.md 中的结构一致，用于生成各驱动的 DSN
+// 并控制三种 ORM 的 SQL 日志；bench 段保存 jormbench run 的默认参数。
+// 同一个文件同时驱动 jormbench 命令和 create_bench / find_bench / update_bench 三个 benchmark 包
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"gopkg.in/yaml.v3"
+)
+
+// FileName 是默认的配置文件名，从当前目录向上查找到 go.mod 所在目录为止
+const FileName = "jormbench.yaml"
+
+// EnvFile 指定配置文件路径的环境变量
+const EnvFile = "JORMBENCH_CONFIG"
+
+// Config 是配置文件的完整结构
+type Config struct {
+	Database Database `yaml:"database"`
+	Bench    Bench    `yaml:"bench"`
+}
+
+// Database 描述数据库连接，字段与 mysql.md 一致，另外增加 SQLite 的文件路径与直接指定的 DSN
+type Database struct {
+	Driver    string `yaml:"driver"`
+	Host      string `yaml:"host"`
+	Port      string `yaml:"port"`
+	Username  string `yaml:"username"`
+	Password  string `yaml:"password"`
+	Database  string `yaml:"database"`
+	Charset   string `yaml:"charset"`
+	ParseTime bool   `yaml:"parseTime"`
+	Loc       string `yaml:"loc"`
+	// DebugSQL 为 true 时三种 ORM 都打印执行的 SQL
+	DebugSQL bool `yaml:"debug_sql"`
+	// Path 是 SQLite 数据库文件，为空时由调用方决定 (benchmark 包使用 test.db)
+	Path string `yaml:"path"`
+	// DSN 非空时直接使用，忽略其余连接字段
+	DSN string `yaml:"dsn"`
+}
+
+// Bench 是 jormbench run 的默认参数，命令行参数优先
+type Bench struct {
+	Scenarios []string      `yaml:"scenarios"`
+	ORMs      []string      `yaml:"orms"`
+	Sizes     []int         `yaml:"sizes"`
+	Storage   []string      `yaml:"storage"`
+	Benchtime time.Duration `yaml:"benchtime"`
+	Count     int           `yaml:"count"`
+	// Pragmas 是 SQLite 连接参数，例如 synchronous: "OFF"，以 go-sqlite3 的 _synchronous=OFF 形式加入 DSN
+	Pragmas map[string]string `yaml:"pragmas"`
+	// Fixtures 是 SQLite 预置数据模板的缓存目录，为空时使用用户缓存目录，off 表示每次重新插入
+	Fixtures string `yaml:"fixtures"`
+}
+
+// Default 返回没有配置文件时的配置：本地 SQLite，MySQL 字段使用 mysql.md 中的默认值
+func Default() *Config {
+	return &Config{
+		Database: Database{
+			Driver:    "sqlite3",
+			Host:      "127.0.0.1",
+			Port:      "3306",
+			Username:  "root",
+			Charset:   "utf8mb4",
+			ParseTime: true,
+			Loc:       "Local",
+		},
+		Bench: Bench{Count: 1},
+	}
+}
+
+// Load 读取 path 并应用环境变量覆盖，文件中缺省的字段保留 Default 的值
+func Load(path string) (*Config, error) {
+	c := Default()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := c.applyEnv(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadDefault 读取 Find 找到的配置文件，找不到时使用 Default，两种情况都应用环境变量覆盖
+func LoadDefault() (*Config, error) {
+	path, err := Find()
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		return Load(path)
+	}
+	c := Default()
+	if err := c.applyEnv(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Find 返回 JORMBENCH_CONFIG 指定的文件，否则从当前目录向上查找 jormbench.yaml，
+// 在包含 go.mod 的目录停止，找不到时返回空字符串
+func Find() (string, error) {
+	if path := os.Getenv(EnvFile); path != "" {
+		return path, nil
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		path := filepath.Join(dir, FileName)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return "", nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// DSN 生成当前驱动的连接串，defaultPath 是 SQLite 未配置 path 时使用的文件
+func (c *Config) DSN(defaultPath string) (string, error) {
+	d := c.Database
+	if d.DSN != "" {
+		return d.DSN, nil
+	}
+	switch d.Driver {
+	case "sqlite3":
+		path := d.Path
+		if path == "" {
+			path = defaultPath
+		}
+		return sqliteDSN(path, c.Bench.Pragmas), nil
+	case "mysql":
+		return d.mysqlDSN()
+	}
+	return "", fmt.Errorf("unsupported driver %q (want sqlite3 or mysql)", d.Driver)
+}
+
+// sqliteDSN 没有 pragma 时直接返回文件路径，与 benchmark 包原来的 DSN 相同
+func sqliteDSN(path string, pragmas map[string]string) string {
+	if len(pragmas) == 0 {
+		return path
+	}
+	keys := make([]string, 0, len(pragmas))
+	for k := range pragmas {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	params := make([]string, len(keys))
+	for i, k := range keys {
+		params[i] = "_" + strings.TrimPrefix(k, "_") + "=" + pragmas[k]
+	}
+	return "file:" + path + "?" + strings.Join(params, "&")
+}
+
+func (d Database) mysqlDSN() (string, error) {
+	mc := mysql.NewConfig()
+	mc.User = d.Username
+	mc.Passwd = d.Password
+	mc.Net = "tcp"
+	mc.Addr = net.JoinHostPort(d.Host, d.Port)
+	mc.DBName = d.Database
+	mc.ParseTime = d.ParseTime
+	if d.Charset != "" {
+		mc.Params = map[string]string{"charset": d.Charset}
+	}
+	if d.Loc != "" {
+		loc, err := time.LoadLocation(d.Loc)
+		if err != nil {
+			return "", fmt.Errorf("database.loc: %w", err)
+		}
+		mc.Loc = loc
+	}
+	return mc.FormatDSN(), nil
+}
+
+// applyEnv 用环境变量覆盖配置，JORMBENCH_<字段名大写> 对应同名字段，
+// 列表用逗号分隔；BENCH_DSN 与 benchmark 包原有的约定一致，直接覆盖 DSN
+func (c *Config) applyEnv() error {
+	d := &c.Database
+	strs := map[string]*string{
+		"JORMBENCH_DRIVER":   &d.Driver,
+		"JORMBENCH_HOST":     &d.Host,
+		"JORMBENCH_PORT":     &d.Port,
+		"JORMBENCH_USERNAME": &d.Username,
+		"JORMBENCH_PASSWORD": &d.Password,
+		"JORMBENCH_DATABASE": &d.Database,
+		"JORMBENCH_CHARSET":  &d.Charset,
+		"JORMBENCH_LOC":      &d.Loc,
+		"JORMBENCH_PATH":     &d.Path,
+		"BENCH_DSN":          &d.DSN,
+		"JORMBENCH_FIXTURES": &c.Bench.Fixtures,
+	}
+	for key, p := range strs {
+		if v, ok := os.LookupEnv(key); ok {
+			*p = v
+		}
+	}
+	bools := map[string]*bool{
+		"JORMBENCH_PARSETIME": &d.ParseTime,
+		"JORMBENCH_DEBUG_SQL": &d.DebugSQL,
+	}
+	for key, p := range bools {
+		if v, ok := os.LookupEnv(key); ok {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			*p = b
+		}
+	}
+
+	b := &c.Bench
+	lists := map[string]*[]string{
+		"JORMBENCH_SCENARIOS": &b.Scenarios,
+		"JORMBENCH_ORMS":      &b.ORMs,
+		"JORMBENCH_STORAGE":   &b.Storage,
+	}
+	for key, p := range lists {
+		if v, ok := os.LookupEnv(key); ok {
+			*p = SplitList(v)
+		}
+	}
+	if v, ok := os.LookupEnv("JORMBENCH_SIZES"); ok {
+		sizes, err := ParseSizes(v)
+		if err != nil {
+			return fmt.Errorf("JORMBENCH_SIZES: %w", err)
+		}
+		b.Sizes = sizes
+	}
+	if v, ok := os.LookupEnv("JORMBENCH_BENCHTIME"); ok {
+		dur, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("JORMBENCH_BENCHTIME: %w", err)
+		}
+		b.Benchtime = dur
+	}
+	if v, ok := os.LookupEnv("JORMBENCH_COUNT"); ok {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("JORMBENCH_COUNT: %w", err)
+		}
+		b.Count = n
+	}
+	return nil
+}
+
+// SplitList 拆分逗号分隔的列表，忽略空项
+func SplitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// ParseSizes 解析逗号分隔的正整数列表
+func ParseSizes(s string) ([]int, error) {
+	var sizes []int
+	for _, item := range SplitList(s) {
+		n, err := strconv.Atoi(item)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid size %q", item)
+		}
+		sizes = append(sizes, n)
+	}
+	return sizes, nil
+}
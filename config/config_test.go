@@ -0,0 +1,91 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+const sample = `
+database:
+  driver: "mysql"
+  host: "db.local"
+  port: "3307"
+  username: "bench"
+  password: "p@ss"
+  database: "jorm"
+  charset: "utf8mb4"
+  parseTime: true
+  loc: "Local"
+  debug_sql: true
+bench:
+  orms: [jorm, gorm]
+  sizes: [1000, 10000]
+  benchtime: 2s
+  pragmas:
+    synchronous: "OFF"
+`
+
+func writeSample(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), FileName)
+	if err := os.WriteFile(path, []byte(sample), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadMySQL(t *testing.T) {
+	c, err := Load(writeSample(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.Database.DebugSQL || !slices.Equal(c.Bench.ORMs, []string{"jorm", "gorm"}) ||
+		!slices.Equal(c.Bench.Sizes, []int{1000, 10000}) || c.Bench.Benchtime != 2*time.Second || c.Bench.Count != 1 {
+		t.Errorf("unexpected config %+v", c)
+	}
+	dsn, err := c.DSN("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "bench:p@ss@tcp(db.local:3307)/jorm?loc=Local&parseTime=true&charset=utf8mb4"; dsn != want {
+		t.Errorf("DSN = %q, want %q", dsn, want)
+	}
+}
+
+func TestEnvOverride(t *testing.T) {
+	t.Setenv("JORMBENCH_DRIVER", "sqlite3")
+	t.Setenv("JORMBENCH_DEBUG_SQL", "false")
+	t.Setenv("JORMBENCH_SIZES", "5,50")
+	c, err := Load(writeSample(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Database.DebugSQL || !slices.Equal(c.Bench.Sizes, []int{5, 50}) {
+		t.Errorf("env not applied: %+v", c)
+	}
+	dsn, err := c.DSN("test.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "file:test.db?_synchronous=OFF"; dsn != want {
+		t.Errorf("DSN = %q, want %q", dsn, want)
+	}
+
+	t.Setenv("BENCH_DSN", "other.db")
+	if c, err = Load(writeSample(t)); err != nil {
+		t.Fatal(err)
+	}
+	if dsn, _ := c.DSN("test.db"); dsn != "other.db" {
+		t.Errorf("BENCH_DSN not applied: %q", dsn)
+	}
+}
+
+func TestDefaultSQLite(t *testing.T) {
+	dsn, err := Default().DSN("test.db")
+	if err != nil || dsn != "test.db" {
+		t.Errorf("DSN = %q, %v", dsn, err)
+	}
+}
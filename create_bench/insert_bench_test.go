@@ -3,6 +3,8 @@ package create_bench
 import (
 	"fmt"
 	"testing"
+
+	"goapi/sqlcount/sqlcounttest"
 )
 
 // prepareUser 生成一条测试数据，index 用于避免完全相同的数据
@@ -16,19 +18,13 @@ func prepareUser(index int) *User {
 // truncateUsers 在每个 benchmark 开始前清空 users 表，保证数据量一致
 func truncateUsers(b *testing.B) {
 	b.Helper()
-	sqlDB, err := NewSQLDB()
+	t, err := benchTarget()
 	if err != nil {
-		b.Fatalf("open sql db: %v", err)
-	}
-	defer sqlDB.Close()
-
-	// SQLite 使用 DELETE 代替 TRUNCATE
-	if _, err := sqlDB.Exec("DELETE FROM users"); err != nil {
-		b.Fatalf("delete users: %v", err)
+		b.Fatalf("load config: %v", err)
 	}
-	// 重置自增 ID
-	if _, err := sqlDB.Exec("DELETE FROM sqlite_sequence WHERE name='users'"); err != nil {
-		// 忽略错误，因为表可能还没有插入过数据
+	// SQLite 使用 DELETE 代替 TRUNCATE，并重置自增 ID
+	if err := t.Seed(0); err != nil {
+		b.Fatalf("truncate users: %v", err)
 	}
 }
 
@@ -42,6 +38,7 @@ func BenchmarkJormInsert(b *testing.B) {
 	defer engine.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		u := prepareUser(i)
 		if _, err := engine.Model(&User{}).Insert(u); err != nil {
@@ -65,6 +62,7 @@ func BenchmarkGormInsert(b *testing.B) {
 	defer sqlDB.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		u := prepareUser(i)
 		if err := db.Create(u).Error; err != nil {
@@ -83,6 +81,7 @@ func BenchmarkXormInsert(b *testing.B) {
 	defer engine.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		u := prepareUser(i)
 		if _, err := engine.Insert(u); err != nil {
@@ -0,0 +1,11 @@
+package create_bench
+
+import (
+	"os"
+	"testing"
+
+	"goapi/scenario"
+)
+
+// TestMain 运行 benchmark 时先输出环境头部，见 scenario.BenchMain
+func TestMain(m *testing.M) { os.Exit(scenario.BenchMain(m)) }
@@ -0,0 +1,212 @@
+// Package ddl 读取 SQLite 中表的实际结构 (sqlite_master、PRAGMA table_info / index_list / index_info)
+// 并比较两张表的列类型、约束与索引，用于对比各 ORM 迁移生成的 DDL
+package ddl
+
+import (
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Column 是 PRAGMA table_info 的一行
+type Column struct {
+	Name    string
+	Type    string
+	NotNull bool
+	// Default 是 dflt_value 的原文，没有默认值时为空
+	Default string
+	// PK 是列在主键中的序号 (从 1 开始)，不是主键时为 0
+	PK int
+}
+
+// Index 是 PRAGMA index_list 的一行及其列
+type Index struct {
+	Name   string
+	Unique bool
+	// Origin 是索引的来源：c 为 CREATE INDEX，u 为 UNIQUE 约束，pk 为主键
+	Origin  string
+	Columns []string
+}
+
+// Table 是一张表的结构
+type Table struct {
+	Name string
+	// SQL 是 sqlite_master 中保存的建表语句
+	SQL     string
+	Columns []Column
+	// Indexes 按名称排序
+	Indexes []Index
+}
+
+// AutoIncrement 报告建表语句是否带 AUTOINCREMENT
+func (t Table) AutoIncrement() bool {
+	return strings.Contains(strings.ToUpper(t.SQL), "AUTOINCREMENT")
+}
+
+// Inspect 读取表结构，表不存在时返回错误
+func Inspect(db *sql.DB, table string) (Table, error) {
+	t := Table{Name: table}
+	err := db.QueryRow("SELECT sql FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&t.SQL)
+	if err != nil {
+		return Table{}, fmt.Errorf("ddl: table %s: %w", table, err)
+	}
+
+	rows, err := db.Query("SELECT name, type, \"notnull\", dflt_value, pk FROM pragma_table_info(?) ORDER BY cid", table)
+	if err != nil {
+		return Table{}, fmt.Errorf("ddl: table_info(%s): %w", table, err)
+	}
+	for rows.Next() {
+		var (
+			c    Column
+			dflt sql.NullString
+		)
+		if err := rows.Scan(&c.Name, &c.Type, &c.NotNull, &dflt, &c.PK); err != nil {
+			rows.Close()
+			return Table{}, err
+		}
+		c.Default = dflt.String
+		t.Columns = append(t.Columns, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return Table{}, err
+	}
+
+	rows, err = db.Query("SELECT name, \"unique\", origin FROM pragma_index_list(?) ORDER BY name", table)
+	if err != nil {
+		return Table{}, fmt.Errorf("ddl: index_list(%s): %w", table, err)
+	}
+	for rows.Next() {
+		var idx Index
+		if err := rows.Scan(&idx.Name, &idx.Unique, &idx.Origin); err != nil {
+			rows.Close()
+			return Table{}, err
+		}
+		t.Indexes = append(t.Indexes, idx)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return Table{}, err
+	}
+	for i := range t.Indexes {
+		cols, err := indexColumns(db, t.Indexes[i].Name)
+		if err != nil {
+			return Table{}, err
+		}
+		t.Indexes[i].Columns = cols
+	}
+	return t, nil
+}
+
+func indexColumns(db *sql.DB, index string) ([]string, error) {
+	rows, err := db.Query("SELECT name FROM pragma_index_info(?) ORDER BY seqno", index)
+	if err != nil {
+		return nil, fmt.Errorf("ddl: index_info(%s): %w", index, err)
+	}
+	defer rows.Close()
+	var cols []string
+	for rows.Next() {
+		var name sql.NullString
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		cols = append(cols, name.String)
+	}
+	return cols, rows.Err()
+}
+
+// Diff 比较两张表，a、b 是报告中两侧的名称。
+// 列类型不区分大小写；rowid 别名列不比较 NOT NULL；索引按 (是否唯一, 列) 匹配，不比较名称
+func Diff(a, b string, x, y Table) []string {
+	var diffs []string
+	if x.AutoIncrement() != y.AutoIncrement() {
+		diffs = append(diffs, fmt.Sprintf("autoincrement: %s=%v %s=%v", a, x.AutoIncrement(), b, y.AutoIncrement()))
+	}
+
+	for _, name := range columnNames(x, y) {
+		cx, okx := x.column(name)
+		cy, oky := y.column(name)
+		switch {
+		case !okx || !oky:
+			diffs = append(diffs, fmt.Sprintf("column %s: %s=%s %s=%s", name, a, cx.describe(okx), b, cy.describe(oky)))
+		case !strings.EqualFold(cx.Type, cy.Type):
+			diffs = append(diffs, fmt.Sprintf("column %s type: %s=%s %s=%s", name, a, cx.Type, b, cy.Type))
+		}
+		if !okx || !oky {
+			continue
+		}
+		if cx.NotNull != cy.NotNull && !x.rowid(cx) {
+			diffs = append(diffs, fmt.Sprintf("column %s not null: %s=%v %s=%v", name, a, cx.NotNull, b, cy.NotNull))
+		}
+		if cx.Default != cy.Default {
+			diffs = append(diffs, fmt.Sprintf("column %s default: %s=%q %s=%q", name, a, cx.Default, b, cy.Default))
+		}
+		if cx.PK != cy.PK {
+			diffs = append(diffs, fmt.Sprintf("column %s primary key: %s=%d %s=%d", name, a, cx.PK, b, cy.PK))
+		}
+	}
+
+	for _, idx := range x.Indexes {
+		if !y.hasIndex(idx) {
+			diffs = append(diffs, fmt.Sprintf("index %s only in %s", idx, a))
+		}
+	}
+	for _, idx := range y.Indexes {
+		if !x.hasIndex(idx) {
+			diffs = append(diffs, fmt.Sprintf("index %s only in %s", idx, b))
+		}
+	}
+	return diffs
+}
+
+func (idx Index) String() string {
+	kind := "index"
+	if idx.Unique {
+		kind = "unique"
+	}
+	return fmt.Sprintf("%s(%s) %s", kind, strings.Join(idx.Columns, ", "), idx.Name)
+}
+
+// rowid 报告列是否是 rowid 的别名 (唯一的 INTEGER PRIMARY KEY)，这样的列不可能为 NULL，
+// 是否写了 NOT NULL 没有区别
+func (t Table) rowid(c Column) bool {
+	if !strings.EqualFold(c.Type, "integer") || c.PK != 1 {
+		return false
+	}
+	return !slices.ContainsFunc(t.Columns, func(o Column) bool { return o.PK > 1 })
+}
+
+func (t Table) hasIndex(idx Index) bool {
+	return slices.ContainsFunc(t.Indexes, func(o Index) bool {
+		return o.Unique == idx.Unique && slices.Equal(o.Columns, idx.Columns)
+	})
+}
+
+func (t Table) column(name string) (Column, bool) {
+	i := slices.IndexFunc(t.Columns, func(c Column) bool { return c.Name == name })
+	if i < 0 {
+		return Column{}, false
+	}
+	return t.Columns[i], true
+}
+
+func (c Column) describe(ok bool) string {
+	if !ok {
+		return "(missing)"
+	}
+	return c.Type
+}
+
+// columnNames 返回两张表的全部列名，先按 x 的顺序，再补上只在 y 中的列
+func columnNames(x, y Table) []string {
+	var names []string
+	for _, t := range []Table{x, y} {
+		for _, c := range t.Columns {
+			if !slices.Contains(names, c.Name) {
+				names = append(names, c.Name)
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,68 @@
+package ddl
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func inspect(t *testing.T, schema ...string) Table {
+	t.Helper()
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "ddl.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for _, s := range schema {
+		if _, err := db.Exec(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tbl, err := Inspect(db, "t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tbl
+}
+
+func TestInspect(t *testing.T) {
+	tbl := inspect(t,
+		"CREATE TABLE t (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL UNIQUE, age INTEGER DEFAULT 18)",
+		"CREATE INDEX idx_t_age ON t (age, name)")
+	if !tbl.AutoIncrement() {
+		t.Error("AUTOINCREMENT not detected")
+	}
+	got := fmt.Sprint(tbl.Columns)
+	want := "[{id INTEGER false  1} {name TEXT true  0} {age INTEGER false 18 0}]"
+	if got != want {
+		t.Errorf("columns = %s, want %s", got, want)
+	}
+	if len(tbl.Indexes) != 2 || !slices.Equal(tbl.Indexes[0].Columns, []string{"age", "name"}) ||
+		!tbl.Indexes[1].Unique || tbl.Indexes[1].Origin != "u" {
+		t.Errorf("indexes = %v", tbl.Indexes)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	x := inspect(t,
+		"CREATE TABLE t (id INTEGER PRIMARY KEY NOT NULL, name text, age INTEGER)",
+		"CREATE UNIQUE INDEX a ON t (name)")
+	y := inspect(t,
+		"CREATE TABLE t (id integer PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, score REAL DEFAULT 0)",
+		"CREATE UNIQUE INDEX b ON t (name)",
+		"CREATE INDEX c ON t (score)")
+	want := []string{
+		"autoincrement: x=false y=true",
+		"column name not null: x=false y=true",
+		"column age: x=INTEGER y=(missing)",
+		"column score: x=(missing) y=REAL",
+		"index index(score) c only in y",
+	}
+	if got := Diff("x", "y", x, y); !slices.Equal(got, want) {
+		t.Errorf("diff =\n%q\nwant\n%q", got, want)
+	}
+}
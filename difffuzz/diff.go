@@ -0,0 +1,220 @@
+package difffuzz
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Outcome 是一次操作的可比较结果。错误信息因 ORM 而异，只比较是否出错
+type Outcome struct {
+	ID       int64
+	Found    bool
+	Affected int64
+	Rows     []User
+	Err      error
+}
+
+func (o Outcome) String() string {
+	if o.Err != nil {
+		return "error"
+	}
+	var parts []string
+	if o.ID != 0 {
+		parts = append(parts, fmt.Sprintf("id=%d", o.ID))
+	}
+	if o.Found {
+		parts = append(parts, "found")
+	}
+	if o.Affected != 0 {
+		parts = append(parts, fmt.Sprintf("affected=%d", o.Affected))
+	}
+	if o.Rows != nil {
+		parts = append(parts, formatRows(o.Rows))
+	}
+	if len(parts) == 0 {
+		return "ok"
+	}
+	return strings.Join(parts, " ")
+}
+
+func formatRows(rows []User) string {
+	parts := make([]string, len(rows))
+	for i, u := range rows {
+		parts[i] = fmt.Sprintf("{%d %s %d}", u.ID, quote(u.Name), u.Age)
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+// Trace 是一个 ORM 执行一串操作的全部结果
+type Trace struct {
+	ORM      string
+	Outcomes []Outcome
+	// Final 是执行完后表中的全部行
+	Final []User
+}
+
+// Run 重置数据库并执行 ops
+func (db *DB) Run(ops []Op) (Trace, error) {
+	if err := db.Reset(); err != nil {
+		return Trace{}, fmt.Errorf("%s: reset: %w", db.ORM, err)
+	}
+	tr := Trace{ORM: db.ORM}
+	for _, op := range ops {
+		tr.Outcomes = append(tr.Outcomes, apply(db.exec, op))
+	}
+	final, err := db.Rows()
+	if err != nil {
+		return Trace{}, fmt.Errorf("%s: read users: %w", db.ORM, err)
+	}
+	tr.Final = final
+	return tr, nil
+}
+
+func apply(e executor, op Op) Outcome {
+	var o Outcome
+	switch op.Kind {
+	case Insert:
+		u := User{Name: op.Name, Age: op.Age}
+		o.Err = e.insert(&u)
+		o.ID = u.ID
+	case First:
+		var u User
+		o.Found, o.Err = e.first(op.ID, &u)
+		if o.Found {
+			o.Rows = []User{u}
+		}
+	case Find:
+		o.Rows = []User{}
+		o.Err = e.find(op.AgeMin, op.AgeMax, op.Limit, &o.Rows)
+	case Update:
+		o.Affected, o.Err = e.update(op.ID, User{Name: op.Name, Age: op.Age})
+	case UpdateWhere:
+		o.Affected, o.Err = e.updateWhere(op.AgeMin, op.AgeMax, map[string]any{"username": op.Name, "age": op.Age})
+	case Delete:
+		o.Affected, o.Err = e.delete(op.ID)
+	case DeleteWhere:
+		o.Affected, o.Err = e.deleteWhere(op.AgeMin, op.AgeMax)
+	}
+	return o
+}
+
+// Diff 描述第一处差异
+type Diff struct {
+	Ops    []Op
+	Traces []Trace
+	// Step 是第一个结果不同的操作下标，-1 表示每一步都相同而最终的表不同
+	Step int
+	// skipKnown 记录比较时是否跳过已知差异，最小化时保持一致
+	skipKnown bool
+}
+
+// Compare 执行 ops 并比较各 ORM 的结果，没有差异时返回 nil，属于 Known 的差异不计
+func Compare(dbs []*DB, ops []Op) (*Diff, error) {
+	return compare(dbs, ops, true)
+}
+
+func compare(dbs []*DB, ops []Op, skipKnown bool) (*Diff, error) {
+	d := &Diff{Ops: ops, Step: -1, skipKnown: skipKnown}
+	for _, db := range dbs {
+		tr, err := db.Run(ops)
+		if err != nil {
+			return nil, err
+		}
+		d.Traces = append(d.Traces, tr)
+	}
+	for i := range ops {
+		if skipKnown && known(ops[i]) != nil {
+			continue
+		}
+		for _, tr := range d.Traces[1:] {
+			if tr.Outcomes[i].String() != d.Traces[0].Outcomes[i].String() {
+				d.Step = i
+				return d, nil
+			}
+		}
+	}
+	for _, tr := range d.Traces[1:] {
+		if !slices.Equal(tr.Final, d.Traces[0].Final) {
+			return d, nil
+		}
+	}
+	return nil, nil
+}
+
+// Minimize 在保持存在差异的前提下逐个删除操作，再把剩余操作的字符串缩短、数值归一，
+// 得到可以直接写进测试的最小复现
+func Minimize(dbs []*DB, d *Diff) (*Diff, error) {
+	best := d
+	try := func(ops []Op) (bool, error) {
+		nd, err := compare(dbs, ops, d.skipKnown)
+		if err != nil || nd == nil {
+			return false, err
+		}
+		best = nd
+		return true, nil
+	}
+	// 出现差异之后的操作一定可以删掉
+	if best.Step >= 0 {
+		if _, err := try(slices.Clone(best.Ops[:best.Step+1])); err != nil {
+			return nil, err
+		}
+	}
+	for i := len(best.Ops) - 1; i >= 0; i-- {
+		if _, err := try(slices.Delete(slices.Clone(best.Ops), i, i+1)); err != nil {
+			return nil, err
+		}
+	}
+	for i := range best.Ops {
+		for _, simplify := range simplifications {
+			ops := slices.Clone(best.Ops)
+			if !simplify(&ops[i]) {
+				continue
+			}
+			if _, err := try(ops); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return best, nil
+}
+
+// simplifications 把一个字段换成更简单的值，值已经最简时返回 false
+var simplifications = []func(*Op) bool{
+	func(o *Op) bool { return replace(&o.Name, "x") },
+	func(o *Op) bool { return replace(&o.Age, 1) },
+	func(o *Op) bool { return replace(&o.Limit, 0) },
+}
+
+func replace[T comparable](p *T, v T) bool {
+	if *p == v {
+		return false
+	}
+	*p = v
+	return true
+}
+
+func (d *Diff) String() string {
+	var b strings.Builder
+	if d.Step >= 0 {
+		fmt.Fprintf(&b, "results differ at step %d:\n", d.Step+1)
+	} else {
+		b.WriteString("final table differs:\n")
+	}
+	for i, op := range d.Ops {
+		fmt.Fprintf(&b, "  %d. %s\n", i+1, op)
+	}
+	for _, tr := range d.Traces {
+		if d.Step >= 0 {
+			o := tr.Outcomes[d.Step]
+			fmt.Fprintf(&b, "  %s: %s", tr.ORM, o)
+			if o.Err != nil {
+				fmt.Fprintf(&b, " (%v)", o.Err)
+			}
+			b.WriteString("\n")
+		} else {
+			fmt.Fprintf(&b, "  %s: %s\n", tr.ORM, formatRows(tr.Final))
+		}
+	}
+	return b.String()
+}
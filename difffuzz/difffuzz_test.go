@@ -0,0 +1,118 @@
+package difffuzz
+
+import (
+	"sync"
+	"testing"
+
+	"goapi/scenario"
+)
+
+// engines 在一个进程内只打开一次，每个输入执行前重置数据库
+var engines = sync.OnceValues(func() ([]*DB, error) {
+	return Open(scenario.ORMs...)
+})
+
+// FuzzCRUD 把输入解码为增删改查操作序列，比较三种 ORM 的每一步结果与最终的表内容，
+// 发现差异时报告最小化后的操作序列：
+//
+//	go test -run='^$' -fuzz=FuzzCRUD -fuzztime=1m ./difffuzz
+func FuzzCRUD(f *testing.F) {
+	f.Add([]byte{byte(Insert), 2, 60, byte(Insert), 3, 61, byte(First), 1, byte(Find), 40, 70, 0})
+	f.Add([]byte{byte(Insert), 4, 50, byte(Insert), 5, 52, byte(UpdateWhere), 40, 51, 3, 45, byte(Find), 40, 60, 1})
+	f.Add([]byte{byte(Insert), 6, 90, byte(Insert), 7, 100, byte(Delete), 1, byte(Insert), 2, 55, byte(DeleteWhere), 80, 95})
+	f.Add([]byte{byte(Insert), 12, 33, byte(Update), 1, 3, 34, byte(First), 1, byte(Update), 5, 2, 40})
+	f.Add([]byte{byte(Insert), 0x83, 0xff, 0xfe, 0x00, 40, byte(Find), 0xa0, 0x7f, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		dbs, err := engines()
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := Compare(dbs, Decode(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d == nil {
+			return
+		}
+		if m, err := Minimize(dbs, d); err == nil {
+			d = m
+		}
+		t.Errorf("ORMs disagree, minimized repro:\n%s", d)
+	})
+}
+
+func TestDecode(t *testing.T) {
+	ops := Decode([]byte{byte(Insert), 0x82, 'h', 'i', 50, byte(Delete) + byte(numKinds), 9, byte(Find)})
+	want := []Op{
+		{Kind: Insert, Name: "hi", Age: 50},
+		{Kind: Delete, ID: 1},
+		{Kind: Find, AgeMin: 0, AgeMax: 0},
+	}
+	if len(ops) != len(want) {
+		t.Fatalf("got %d ops: %v", len(ops), ops)
+	}
+	for i := range want {
+		if ops[i] != want[i] {
+			t.Errorf("op %d = %v, want %v", i, ops[i], want[i])
+		}
+	}
+	if n := len(Decode(make([]byte, 1000))); n != MaxOps {
+		t.Errorf("decoded %d ops, want at most %d", n, MaxOps)
+	}
+}
+
+// TestKnownDiffs 确认已知差异仍然存在，ORM 修复后这里会失败，提示删除对应条目
+func TestKnownDiffs(t *testing.T) {
+	dbs, err := engines()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range Known {
+		t.Run(k.Name, func(t *testing.T) {
+			if !k.Match(k.Repro[len(k.Repro)-1]) {
+				t.Fatal("repro does not match")
+			}
+			d, err := compare(dbs, k.Repro, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d == nil {
+				t.Errorf("%s no longer reproduces; remove it from Known", k.Name)
+			}
+		})
+	}
+}
+
+func TestMinimize(t *testing.T) {
+	dbs, err := engines()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ops := []Op{
+		{Kind: Insert, Name: "a", Age: 20},
+		{Kind: Find, AgeMin: 0, AgeMax: 100, Limit: 3},
+		{Kind: Insert, Name: "b", Age: 21},
+		Known[0].Repro[0],
+		{Kind: Delete, ID: 2},
+	}
+	d, err := compare(dbs, ops, false)
+	if err != nil || d == nil {
+		t.Fatalf("compare = %v, %v", d, err)
+	}
+	m, err := Minimize(dbs, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Ops) != 1 || m.Ops[0].Kind != Update || m.Step != 0 {
+		t.Errorf("minimized to:\n%s", m)
+	}
+}
+
+func TestOpenExecError(t *testing.T) {
+	target := scenario.Target{Driver: "nosuchdriver", DSN: "x"}
+	for _, orm := range append(scenario.ORMs, "nosuchorm") {
+		if exec, err := openExec(orm, target); err == nil || exec != nil {
+			t.Errorf("openExec(%s) = %v, %v; want an error", orm, exec, err)
+		}
+	}
+}
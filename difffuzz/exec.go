@@ -0,0 +1,278 @@
+package difffuzz
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/shrek82/jorm"
+	"github.com/shrek82/jorm/core"
+	"gorm.io/gorm"
+	"xorm.io/xorm"
+	xormlog "xorm.io/xorm/log"
+
+	"goapi/report"
+	"goapi/scenario"
+)
+
+// User 与其他 benchmark 使用同一个模型
+type User = scenario.User
+
+// executor 用一种 ORM 执行操作
+type executor interface {
+	insert(u *User) error
+	// first 按 ID 查询，找不到时返回 false 与 nil
+	first(id int64, u *User) (bool, error)
+	find(ageMin, ageMax, limit int, users *[]User) error
+	update(id int64, u User) (int64, error)
+	updateWhere(ageMin, ageMax int, set map[string]any) (int64, error)
+	delete(id int64) (int64, error)
+	deleteWhere(ageMin, ageMax int) (int64, error)
+	close() error
+}
+
+// DB 是一个 ORM 与它独占的 SQLite 内存数据库
+type DB struct {
+	ORM  string
+	exec executor
+	// raw 用于重置与读取表内容，同时让内存数据库在 ORM 的连接关闭后仍然存活
+	raw    *sql.DB
+	target scenario.Target
+}
+
+// Open 为每个 ORM 打开一个独立的 SQLite 内存数据库并建表，数据库名带进程号，模糊测试的多个进程互不干扰
+func Open(orms ...string) ([]*DB, error) {
+	var dbs []*DB
+	for _, orm := range orms {
+		db, err := open(orm)
+		if err != nil {
+			Close(dbs)
+			return nil, err
+		}
+		dbs = append(dbs, db)
+	}
+	return dbs, nil
+}
+
+func open(orm string) (*DB, error) {
+	t := scenario.Target{
+		Driver:   "sqlite3",
+		DSN:      fmt.Sprintf("file:difffuzz_%d_%s?mode=memory&cache=shared&_busy_timeout=5000", os.Getpid(), orm),
+		Storage:  scenario.StorageMemory,
+		Fixtures: scenario.FixturesOff,
+	}
+	raw, err := sql.Open(t.Driver, t.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := raw.Ping(); err != nil {
+		raw.Close()
+		return nil, err
+	}
+	db := &DB{ORM: orm, raw: raw, target: t}
+	if err := db.Reset(); err != nil {
+		raw.Close()
+		return nil, err
+	}
+	exec, err := openExec(orm, t)
+	if err != nil {
+		raw.Close()
+		return nil, err
+	}
+	db.exec = exec
+	return db, nil
+}
+
+// openExec 用 orm 连接 t，打开失败时返回错误，不会返回 nil 的 executor
+func openExec(orm string, t scenario.Target) (executor, error) {
+	switch orm {
+	case report.ORMJorm:
+		j, err := scenario.OpenJorm(t)
+		if err != nil {
+			return nil, err
+		}
+		return jormExec{j}, nil
+	case report.ORMGorm:
+		g, err := scenario.OpenGorm(t)
+		if err != nil {
+			return nil, err
+		}
+		return gormExec{g}, nil
+	case report.ORMXorm:
+		x, err := scenario.OpenXorm(t)
+		if err != nil {
+			return nil, err
+		}
+		x.SetLogLevel(xormlog.LOG_ERR)
+		return xormExec{x}, nil
+	}
+	return nil, fmt.Errorf("unknown orm %q", orm)
+}
+
+// Reset 清空 users 并重置自增 ID
+func (db *DB) Reset() error {
+	return db.target.Seed(0)
+}
+
+// Rows 按 id 顺序返回表中的全部行
+func (db *DB) Rows() ([]User, error) {
+	rows, err := db.raw.Query("SELECT id, username, age FROM users ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var users []User
+	for rows.Next() {
+		var u User
+		if err := rows.Scan(&u.ID, &u.Name, &u.Age); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+// Close 关闭 ORM 与数据库
+func (db *DB) Close() error {
+	var err error
+	if db.exec != nil {
+		err = db.exec.close()
+	}
+	return errors.Join(err, db.raw.Close())
+}
+
+// Close 关闭全部数据库
+func Close(dbs []*DB) {
+	for _, db := range dbs {
+		db.Close()
+	}
+}
+
+type jormExec struct{ db *jorm.DB }
+
+func (e jormExec) insert(u *User) error {
+	_, err := e.db.Model(&User{}).Insert(u)
+	return err
+}
+
+func (e jormExec) first(id int64, u *User) (bool, error) {
+	err := e.db.Model(u).Where("id = ?", id).First(u)
+	if errors.Is(err, core.ErrRecordNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (e jormExec) find(ageMin, ageMax, limit int, users *[]User) error {
+	q := e.db.Model(&User{}).Where("age BETWEEN ? AND ?", ageMin, ageMax).OrderBy("id")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	return q.Find(users)
+}
+
+func (e jormExec) update(id int64, u User) (int64, error) {
+	return e.db.Model(&User{}).Where("id = ?", id).Update(u)
+}
+
+func (e jormExec) updateWhere(ageMin, ageMax int, set map[string]any) (int64, error) {
+	return e.db.Model(&User{}).Where("age BETWEEN ? AND ?", ageMin, ageMax).Update(set)
+}
+
+func (e jormExec) delete(id int64) (int64, error) {
+	return e.db.Model(&User{}).Where("id = ?", id).Delete()
+}
+
+func (e jormExec) deleteWhere(ageMin, ageMax int) (int64, error) {
+	return e.db.Model(&User{}).Where("age BETWEEN ? AND ?", ageMin, ageMax).Delete()
+}
+
+func (e jormExec) close() error { return e.db.Close() }
+
+type gormExec struct{ db *gorm.DB }
+
+func (e gormExec) insert(u *User) error {
+	return e.db.Create(u).Error
+}
+
+func (e gormExec) first(id int64, u *User) (bool, error) {
+	err := e.db.Where("id = ?", id).First(u).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (e gormExec) find(ageMin, ageMax, limit int, users *[]User) error {
+	q := e.db.Where("age BETWEEN ? AND ?", ageMin, ageMax).Order("id")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	return q.Find(users).Error
+}
+
+func (e gormExec) update(id int64, u User) (int64, error) {
+	res := e.db.Where("id = ?", id).Updates(&u)
+	return res.RowsAffected, res.Error
+}
+
+func (e gormExec) updateWhere(ageMin, ageMax int, set map[string]any) (int64, error) {
+	res := e.db.Model(&User{}).Where("age BETWEEN ? AND ?", ageMin, ageMax).Updates(set)
+	return res.RowsAffected, res.Error
+}
+
+func (e gormExec) delete(id int64) (int64, error) {
+	res := e.db.Where("id = ?", id).Delete(&User{})
+	return res.RowsAffected, res.Error
+}
+
+func (e gormExec) deleteWhere(ageMin, ageMax int) (int64, error) {
+	res := e.db.Where("age BETWEEN ? AND ?", ageMin, ageMax).Delete(&User{})
+	return res.RowsAffected, res.Error
+}
+
+func (e gormExec) close() error {
+	sqlDB, err := e.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+type xormExec struct{ engine *xorm.Engine }
+
+func (e xormExec) insert(u *User) error {
+	_, err := e.engine.Insert(u)
+	return err
+}
+
+func (e xormExec) first(id int64, u *User) (bool, error) {
+	return e.engine.ID(id).Get(u)
+}
+
+func (e xormExec) find(ageMin, ageMax, limit int, users *[]User) error {
+	s := e.engine.Where("age BETWEEN ? AND ?", ageMin, ageMax).Asc("id")
+	if limit > 0 {
+		s = s.Limit(limit)
+	}
+	return s.Find(users)
+}
+
+func (e xormExec) update(id int64, u User) (int64, error) {
+	return e.engine.ID(id).Update(&u)
+}
+
+func (e xormExec) updateWhere(ageMin, ageMax int, set map[string]any) (int64, error) {
+	return e.engine.Table(&User{}).Where("age BETWEEN ? AND ?", ageMin, ageMax).Update(set)
+}
+
+func (e xormExec) delete(id int64) (int64, error) {
+	return e.engine.ID(id).Delete(&User{})
+}
+
+func (e xormExec) deleteWhere(ageMin, ageMax int) (int64, error) {
+	return e.engine.Where("age BETWEEN ? AND ?", ageMin, ageMax).Delete(&User{})
+}
+
+func (e xormExec) close() error { return e.engine.Close() }
@@ -0,0 +1,34 @@
+package difffuzz
+
+// KnownDiff 是已经确认并记录在案的行为差异。Compare 遇到与之匹配的操作时跳过这一步的比较，
+// 模糊测试可以继续寻找新的差异；TestKnownDiffs 保证每一项仍然能复现，ORM 修复后应删除对应条目
+type KnownDiff struct {
+	Name string
+	// Doc 说明各 ORM 的行为
+	Doc string
+	// Match 报告这一步的差异是否属于本条目
+	Match func(op Op) bool
+	// Repro 是最小复现
+	Repro []Op
+}
+
+// Known 是已知差异
+var Known = []KnownDiff{
+	{
+		Name: "empty-struct-update",
+		Doc: "结构体更新只写非零字段，全部字段为零值时 jorm 生成 UPDATE users SET WHERE ... 报语法错误，" +
+			"xorm 返回 no columns found to be updated，gorm 不执行 SQL 并返回成功",
+		Match: func(op Op) bool { return op.Kind == Update && op.Name == "" && op.Age == 0 },
+		Repro: []Op{{Kind: Update, ID: 1}},
+	},
+}
+
+// known 返回与 op 匹配的已知差异
+func known(op Op) *KnownDiff {
+	for i := range Known {
+		if Known[i].Match(op) {
+			return &Known[i]
+		}
+	}
+	return nil
+}
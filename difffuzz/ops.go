@@ -0,0 +1,150 @@
+// Package difffuzz 对 jorm / gorm / xorm 做差分测试：同一串随机的增删改查操作
+// 分别通过三种 ORM 作用在各自独立的数据库上，比较每一步的返回结果与最终的表内容
+package difffuzz
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Kind 是操作类型
+type Kind uint8
+
+const (
+	Insert      Kind = iota // 插入一行，比较生成的 ID
+	First                   // 按 ID 查询，比较是否找到与行内容
+	Find                    // 按 age 范围查询 (按 id 排序，可带 LIMIT)，比较返回的行
+	Update                  // 按 ID 用结构体更新 (各 ORM 只写非零字段)，比较影响行数
+	UpdateWhere             // 按 age 范围用 map 更新 username 与 age，比较影响行数
+	Delete                  // 按 ID 删除，比较影响行数
+	DeleteWhere             // 按 age 范围删除，比较影响行数
+	numKinds
+)
+
+var kindNames = [...]string{"insert", "first", "find", "update", "update-where", "delete", "delete-where"}
+
+func (k Kind) String() string { return kindNames[k] }
+
+// Op 是一次操作，只有与 Kind 相关的字段有意义
+type Op struct {
+	Kind   Kind
+	ID     int64
+	Name   string
+	Age    int
+	AgeMin int
+	AgeMax int
+	Limit  int
+}
+
+func (o Op) String() string {
+	switch o.Kind {
+	case Insert:
+		return fmt.Sprintf("insert(name=%s, age=%d)", quote(o.Name), o.Age)
+	case First, Delete:
+		return fmt.Sprintf("%s(id=%d)", o.Kind, o.ID)
+	case Find:
+		return fmt.Sprintf("find(age between %d and %d, limit=%d)", o.AgeMin, o.AgeMax, o.Limit)
+	case Update:
+		return fmt.Sprintf("update(id=%d, name=%s, age=%d)", o.ID, quote(o.Name), o.Age)
+	case UpdateWhere:
+		return fmt.Sprintf("update-where(age between %d and %d, name=%s, age=%d)", o.AgeMin, o.AgeMax, quote(o.Name), o.Age)
+	case DeleteWhere:
+		return fmt.Sprintf("delete-where(age between %d and %d)", o.AgeMin, o.AgeMax)
+	}
+	return "?"
+}
+
+// quote 输出带引号的字符串，过长时只显示开头与长度
+func quote(s string) string {
+	if len(s) > 40 {
+		return fmt.Sprintf("%q...(%d bytes)", s[:20], len(s))
+	}
+	return fmt.Sprintf("%q", s)
+}
+
+// MaxOps 限制一个输入解码出的操作数
+const MaxOps = 32
+
+// maxID 让按 ID 的操作大多落在已插入的行上，0 永远不存在
+const maxID = 8
+
+// names 是容易暴露差异的字符串：空串、空白、多字节、引号、NUL、SQL 关键字与超长字符串
+var names = []string{
+	"",
+	" ",
+	"user",
+	"用户名",
+	"🚀 emoji",
+	"O'Brien",
+	`quote"back\slash`,
+	"NULL",
+	"a\x00b",
+	"\t\r\n",
+	"%_",
+	"Ünïcödé​",
+	strings.Repeat("长", 1000),
+	strings.Repeat("x", 1<<16),
+}
+
+// ages 是整数的边界值
+var ages = []int{0, 1, -1, 20, 49, math.MaxInt32, math.MinInt32, math.MaxInt64, math.MinInt64}
+
+// Decode 把模糊测试的输入解码为操作序列，任意输入都能解码，数据不足时以 0 补齐
+func Decode(data []byte) []Op {
+	r := reader{data: data}
+	var ops []Op
+	for len(r.data) > 0 && len(ops) < MaxOps {
+		op := Op{Kind: Kind(r.byte() % byte(numKinds))}
+		switch op.Kind {
+		case Insert:
+			op.Name, op.Age = r.name(), r.age()
+		case First, Delete:
+			op.ID = int64(r.byte() % maxID)
+		case Update:
+			op.ID, op.Name, op.Age = int64(r.byte()%maxID), r.name(), r.age()
+		case Find:
+			op.AgeMin, op.AgeMax, op.Limit = r.age(), r.age(), int(r.byte()%8)
+		case UpdateWhere:
+			op.AgeMin, op.AgeMax, op.Name, op.Age = r.age(), r.age(), r.name(), r.age()
+		case DeleteWhere:
+			op.AgeMin, op.AgeMax = r.age(), r.age()
+		}
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+type reader struct {
+	data []byte
+}
+
+func (r *reader) byte() byte {
+	if len(r.data) == 0 {
+		return 0
+	}
+	b := r.data[0]
+	r.data = r.data[1:]
+	return b
+}
+
+// name 的最高位为 0 时从 names 中选取，否则随后的 b&0x3f 个字节原样作为字符串 (可能不是合法的 UTF-8)
+func (r *reader) name() string {
+	b := r.byte()
+	if b&0x80 == 0 {
+		return names[int(b)%len(names)]
+	}
+	n := min(int(b&0x3f), len(r.data))
+	s := string(r.data[:n])
+	r.data = r.data[n:]
+	return s
+}
+
+// age 小于 32 时选取边界值，否则是 int8 范围内的值
+func (r *reader) age() int {
+	b := r.byte()
+	if b < 32 {
+		return ages[int(b)%len(ages)]
+	}
+	return int(int8(b))
+}
@@ -0,0 +1,209 @@
+// Package envinfo 采集运行 benchmark 的环境 (Go 版本、CPU、SQLite 版本、ORM 模块版本、DSN 模式、git 提交)，
+// 以 go test -bench 头部键值的形式附加到每个结果集，报告中的"测试环境"由此生成，不再手写
+package envinfo
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// 结果头部中的键，report 只把由小写字母、- 和 _ 组成的键识别为头部
+const (
+	KeyDate       = "date"
+	KeyGo         = "go"
+	KeyGOOS       = "goos"
+	KeyGOARCH     = "goarch"
+	KeyCPU        = "cpu"
+	KeyCores      = "cores"
+	KeyGOMAXPROCS = "gomaxprocs"
+	KeyGovernor   = "governor" // Linux CPU 调频策略，各核不一致时以逗号分隔
+	KeySQLite     = "sqlite"
+	KeyDSN        = "dsn"
+	KeyCommit     = "commit"
+)
+
+// Module 是需要记录版本的依赖模块
+type Module struct {
+	Key  string
+	Path string
+}
+
+// Modules 是记录版本的 ORM 与驱动模块
+var Modules = []Module{
+	{"jorm", "github.com/shrek82/jorm"},
+	{"gorm", "gorm.io/gorm"},
+	{"xorm", "xorm.io/xorm"},
+	{"sqlite-driver", "github.com/mattn/go-sqlite3"},
+	{"mysql-driver", "github.com/go-sql-driver/mysql"},
+}
+
+// Labels 是报告中展示的键与中文名称，按展示顺序排列
+var Labels = []struct{ Key, Label string }{
+	{KeyDate, "测试时间"},
+	{KeyGOOS, "操作系统"},
+	{KeyGOARCH, "架构"},
+	{KeyCPU, "CPU"},
+	{KeyCores, "CPU 核数"},
+	{KeyGOMAXPROCS, "GOMAXPROCS"},
+	{KeyGovernor, "CPU 调频策略"},
+	{KeyGo, "Go 版本"},
+	{KeySQLite, "SQLite 版本"},
+	{KeyDSN, "数据库模式"},
+	{"jorm", "jorm"},
+	{"gorm", "gorm"},
+	{"xorm", "xorm"},
+	{"sqlite-driver", "go-sqlite3"},
+	{"mysql-driver", "go-sql-driver/mysql"},
+	{KeyCommit, "git 提交"},
+}
+
+// Collect 采集当前进程的环境，dsn 是数据库模式 (例如 sqlite3/file)，为空时不记录
+func Collect(dsn string) map[string]string {
+	env := map[string]string{
+		KeyDate:       time.Now().Format("2006-01-02"),
+		KeyGo:         runtime.Version(),
+		KeyGOOS:       runtime.GOOS,
+		KeyGOARCH:     runtime.GOARCH,
+		KeyCores:      strconv.Itoa(runtime.NumCPU()),
+		KeyGOMAXPROCS: strconv.Itoa(runtime.GOMAXPROCS(0)),
+	}
+	if cpu := cpuModel(); cpu != "" {
+		env[KeyCPU] = cpu
+	}
+	if gs := governors(); len(gs) > 0 {
+		env[KeyGovernor] = strings.Join(gs, ",")
+	}
+	if v, _, _ := sqlite3.Version(); v != "" {
+		env[KeySQLite] = v
+	}
+	for _, m := range Modules {
+		env[m.Key] = ModuleVersion(m.Path)
+	}
+	if dsn != "" {
+		env[KeyDSN] = dsn
+	}
+	if c := gitCommit(); c != "" {
+		env[KeyCommit] = c
+	}
+	return env
+}
+
+// Annotate 把 Collect 的结果补充到 cfg 中，cfg 已有的键 (例如 go test 输出的 cpu) 保持不变
+func Annotate(cfg map[string]string, dsn string) {
+	for k, v := range Collect(dsn) {
+		if _, ok := cfg[k]; !ok {
+			cfg[k] = v
+		}
+	}
+}
+
+// PrintHeader 以 "key: value" 行输出环境，供 benchmark 包的 TestMain 在结果之前打印；
+// testing 包自己会输出的 goos / goarch / cpu 不重复输出
+func PrintHeader(w io.Writer, dsn string) {
+	env := Collect(dsn)
+	for _, l := range Labels {
+		switch l.Key {
+		case KeyGOOS, KeyGOARCH, KeyCPU:
+			continue
+		}
+		if v, ok := env[l.Key]; ok {
+			fmt.Fprintf(w, "%s: %s\n", l.Key, v)
+		}
+	}
+}
+
+// ModuleVersion 从当前二进制的构建信息中读取依赖模块的版本，找不到时返回 "unknown"
+func ModuleVersion(path string) string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != path {
+			continue
+		}
+		if r := dep.Replace; r != nil {
+			if r.Version != "" {
+				return r.Version
+			}
+			return r.Path
+		}
+		return dep.Version
+	}
+	return "unknown"
+}
+
+// cpuModel 返回 CPU 型号，与 go test 输出的 cpu 行一致，取不到时为空
+func cpuModel() string {
+	switch runtime.GOOS {
+	case "linux":
+		data, err := os.ReadFile("/proc/cpuinfo")
+		if err != nil {
+			return ""
+		}
+		sc := bufio.NewScanner(bytes.NewReader(data))
+		for sc.Scan() {
+			k, v, ok := strings.Cut(sc.Text(), ":")
+			if !ok {
+				continue
+			}
+			switch strings.TrimSpace(k) {
+			case "model name", "Hardware", "cpu model":
+				return strings.TrimSpace(v)
+			}
+		}
+	case "darwin":
+		out, err := exec.Command("sysctl", "-n", "machdep.cpu.brand_string").Output()
+		if err == nil {
+			return strings.TrimSpace(string(out))
+		}
+	}
+	return ""
+}
+
+// gitCommit 优先使用 go build 写入构建信息的 vcs.revision，go run / go test 构建的二进制没有这项时调用 git，
+// 工作区有未提交的修改时追加 -dirty
+func gitCommit() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		var rev string
+		dirty := false
+		for _, s := range info.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				rev = s.Value
+			case "vcs.modified":
+				dirty = s.Value == "true"
+			}
+		}
+		if rev != "" {
+			return shortRev(rev, dirty)
+		}
+	}
+	out, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	status, err := exec.Command("git", "status", "--porcelain", "--untracked-files=no").Output()
+	return shortRev(strings.TrimSpace(string(out)), err == nil && len(bytes.TrimSpace(status)) > 0)
+}
+
+func shortRev(rev string, dirty bool) string {
+	if len(rev) > 12 {
+		rev = rev[:12]
+	}
+	if dirty {
+		rev += "-dirty"
+	}
+	return rev
+}
@@ -0,0 +1,42 @@
+package envinfo
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCollect(t *testing.T) {
+	env := Collect("sqlite3/memory")
+	for _, k := range []string{KeyGo, KeyGOOS, KeyGOARCH, KeyCores, KeyGOMAXPROCS, KeySQLite, KeyDSN, "jorm", "gorm", "xorm"} {
+		if env[k] == "" {
+			t.Errorf("missing %s", k)
+		}
+	}
+	if env[KeyDSN] != "sqlite3/memory" {
+		t.Errorf("dsn = %q", env[KeyDSN])
+	}
+}
+
+func TestPrintHeader(t *testing.T) {
+	var buf bytes.Buffer
+	PrintHeader(&buf, "mysql")
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		k, _, ok := strings.Cut(line, ": ")
+		if !ok || strings.Trim(k, "abcdefghijklmnopqrstuvwxyz-_") != "" {
+			t.Errorf("header line %q is not a benchmark config line", line)
+		}
+		if k == KeyGOOS || k == KeyCPU {
+			t.Errorf("%s is printed by testing itself", k)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	base := map[string]string{KeyGo: "go1.25.4", KeyCPU: "A", KeyDate: "2026-01-13", "jorm": "v1"}
+	cur := map[string]string{KeyGo: "go1.26.0", KeyCPU: "A", KeyDate: "2026-02-01", "jorm": "v1"}
+	got := Diff(base, cur)
+	if len(got) != 1 || got[0] != "Go 版本: go1.25.4 → go1.26.0" {
+		t.Errorf("Diff = %q", got)
+	}
+}
@@ -0,0 +1,54 @@
+package envinfo
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteMarkdown 按 Labels 的顺序把 cfg 中的环境信息输出为 markdown 列表，没有任何环境键时不输出
+func WriteMarkdown(w io.Writer, cfg map[string]string) {
+	for _, l := range Labels {
+		if v, ok := cfg[l.Key]; ok && v != "" {
+			fmt.Fprintf(w, "- **%s**: %s\n", l.Label, v)
+		}
+	}
+}
+
+// Has 是否记录了环境信息 (Go 版本或任一模块版本)
+func Has(cfg map[string]string) bool {
+	if _, ok := cfg[KeyGo]; ok {
+		return true
+	}
+	for _, m := range Modules {
+		if _, ok := cfg[m.Key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff 返回两次运行环境不同的项，例如 "CPU: A → B"，测试时间与 git 提交总会不同，不计入
+func Diff(base, cur map[string]string) []string {
+	var out []string
+	for _, l := range Labels {
+		if l.Key == KeyDate || l.Key == KeyCommit {
+			continue
+		}
+		b, bok := base[l.Key]
+		c, cok := cur[l.Key]
+		if !bok || !cok || b == c {
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s: %s → %s", l.Label, b, c))
+	}
+	return out
+}
+
+// Mode 返回结果头部中的数据库模式，SQLite 带存储模式，例如 sqlite3/memory
+func Mode(driver string, storages ...string) string {
+	if driver != "sqlite3" || len(storages) == 0 {
+		return driver
+	}
+	return driver + "/" + strings.Join(storages, ",")
+}
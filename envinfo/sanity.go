@@ -0,0 +1,56 @@
+package envinfo
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// sysRoot 是读取 /sys 与 /proc 的根目录，测试时替换
+var sysRoot = "/"
+
+// governors 返回各 CPU 的 scaling_governor (去重)，不是 Linux 或没有 cpufreq 时为空
+func governors() []string {
+	paths, _ := filepath.Glob(filepath.Join(sysRoot, "sys/devices/system/cpu/cpu[0-9]*/cpufreq/scaling_governor"))
+	var out []string
+	for _, p := range paths {
+		if g := readTrim(p); g != "" && !slices.Contains(out, g) {
+			out = append(out, g)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// Check 检查会让测量结果不可信的环境设置，返回警告列表：
+// CPU 调频策略不是 performance、开启了睿频、系统负载已经占满 CPU
+func Check() []string {
+	var warns []string
+	if gs := governors(); len(gs) > 0 && !slices.Equal(gs, []string{"performance"}) {
+		warns = append(warns, fmt.Sprintf("CPU 调频策略为 %s，频率会随负载变化，建议切换为 performance (cpupower frequency-set -g performance)",
+			strings.Join(gs, ",")))
+	}
+	if readTrim(filepath.Join(sysRoot, "sys/devices/system/cpu/intel_pstate/no_turbo")) == "0" ||
+		readTrim(filepath.Join(sysRoot, "sys/devices/system/cpu/cpufreq/boost")) == "1" {
+		warns = append(warns, "CPU 睿频已开启，温度与负载会影响频率，建议在测试期间关闭")
+	}
+	if fields := strings.Fields(readTrim(filepath.Join(sysRoot, "proc/loadavg"))); len(fields) > 0 {
+		// 1 分钟平均负载超过一半的核数，说明有其他进程在争抢 CPU
+		if load, err := strconv.ParseFloat(fields[0], 64); err == nil && load > float64(runtime.NumCPU())/2 {
+			warns = append(warns, fmt.Sprintf("系统 1 分钟平均负载 %.2f (共 %d 核)，后台进程会干扰测量", load, runtime.NumCPU()))
+		}
+	}
+	return warns
+}
+
+func readTrim(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
@@ -0,0 +1,43 @@
+package envinfo
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	root := t.TempDir()
+	write := func(path, data string) {
+		p := filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(data+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("sys/devices/system/cpu/cpu0/cpufreq/scaling_governor", "powersave")
+	write("sys/devices/system/cpu/cpu1/cpufreq/scaling_governor", "performance")
+	write("sys/devices/system/cpu/intel_pstate/no_turbo", "0")
+	write("proc/loadavg", "0.00 0.01 0.05 1/100 42")
+
+	old := sysRoot
+	sysRoot = root
+	defer func() { sysRoot = old }()
+
+	warns := Check()
+	if len(warns) != 2 || !strings.Contains(warns[0], "performance,powersave") || !strings.Contains(warns[1], "睿频") {
+		t.Errorf("Check() = %q", warns)
+	}
+	if env := Collect(""); env[KeyGovernor] != "performance,powersave" {
+		t.Errorf("governor = %q", env[KeyGovernor])
+	}
+
+	write("sys/devices/system/cpu/cpu0/cpufreq/scaling_governor", "performance")
+	write("sys/devices/system/cpu/intel_pstate/no_turbo", "1")
+	if warns := Check(); len(warns) != 0 {
+		t.Errorf("Check() = %q, want none", warns)
+	}
+}
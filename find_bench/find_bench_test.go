@@ -1,39 +1,21 @@
 package find_bench
 
 import (
-	"fmt"
 	"testing"
+
+	"goapi/sqlcount/sqlcounttest"
 )
 
 // setupTestData 在每个 benchmark 开始前准备测试数据
 func setupTestData(b *testing.B, count int) {
 	b.Helper()
-	sqlDB, err := NewSQLDB()
+	t, err := benchTarget()
 	if err != nil {
-		b.Fatalf("open sql db: %v", err)
-	}
-	defer sqlDB.Close()
-
-	// 清空表
-	if _, err := sqlDB.Exec("DELETE FROM users"); err != nil {
-		b.Fatalf("delete users: %v", err)
-	}
-	if _, err := sqlDB.Exec("DELETE FROM sqlite_sequence WHERE name='users'"); err != nil {
-		// 忽略错误
+		b.Fatalf("load config: %v", err)
 	}
-
-	// 插入测试数据
-	stmt, err := sqlDB.Prepare("INSERT INTO users (username, age) VALUES (?, ?)")
-	if err != nil {
-		b.Fatalf("prepare insert: %v", err)
-	}
-	defer stmt.Close()
-
-	for i := 1; i <= count; i++ {
-		_, err := stmt.Exec(fmt.Sprintf("user_%d", i), 20+i%30)
-		if err != nil {
-			b.Fatalf("insert data: %v", err)
-		}
+	// 清空表、重置自增 ID 并插入测试数据，按配置的驱动执行对应的 SQL
+	if err := t.Seed(count); err != nil {
+		b.Fatalf("seed users: %v", err)
 	}
 }
 
@@ -49,6 +31,7 @@ func BenchmarkJormFindByID(b *testing.B) {
 	defer engine.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		var user User
 		// 查询 ID 在 1-1000 之间的随机记录
@@ -75,6 +58,7 @@ func BenchmarkGormFindByID(b *testing.B) {
 	defer sqlDB.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		var user User
 		queryID := int64(i%1000 + 1)
@@ -95,6 +79,7 @@ func BenchmarkXormFindByID(b *testing.B) {
 	defer engine.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		var user User
 		queryID := int64(i%1000 + 1)
@@ -120,6 +105,7 @@ func BenchmarkJormFindLimit(b *testing.B) {
 	defer engine.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		var users []User
 		err := engine.Model(&User{}).Limit(100).Find(&users)
@@ -147,6 +133,7 @@ func BenchmarkGormFindLimit(b *testing.B) {
 	defer sqlDB.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		var users []User
 		if err := db.Limit(100).Find(&users).Error; err != nil {
@@ -169,6 +156,7 @@ func BenchmarkXormFindLimit(b *testing.B) {
 	defer engine.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		var users []User
 		if err := engine.Limit(100).Find(&users); err != nil {
@@ -192,6 +180,7 @@ func BenchmarkJormFindAll(b *testing.B) {
 	defer engine.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		var users []User
 		err := engine.Model(&User{}).Find(&users)
@@ -216,6 +205,7 @@ func BenchmarkGormFindAll(b *testing.B) {
 	defer sqlDB.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		var users []User
 		if err := db.Find(&users).Error; err != nil {
@@ -235,6 +225,7 @@ func BenchmarkXormFindAll(b *testing.B) {
 	defer engine.Close()
 
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		var users []User
 		if err := engine.Find(&users); err != nil {
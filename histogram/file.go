@@ -0,0 +1,71 @@
+package histogram
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Entry 是一个场景、一个 ORM 的延迟直方图
+type Entry struct {
+	// Name 与 benchmark 结果名称一致，例如 JormFindByID，合并时按 Name 配对
+	Name      string     `json:"name"`
+	ORM       string     `json:"orm"`
+	Scenario  string     `json:"scenario"`
+	Histogram *Histogram `json:"histogram"`
+}
+
+// File 是保存到磁盘的一组直方图，多台机器或多次运行的文件可以用 Merge 合并
+type File struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Config    map[string]string `json:"config,omitempty"`
+	Entries   []Entry           `json:"entries"`
+}
+
+// Save 以缩进 JSON 写入 path
+func (f *File) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Load 读取 Save 写出的文件
+func Load(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f File
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &f, nil
+}
+
+// Add 把 e 合并到同名条目中，没有同名条目时追加
+func (f *File) Add(e Entry) {
+	for i := range f.Entries {
+		if f.Entries[i].Name == e.Name {
+			f.Entries[i].Histogram.Merge(e.Histogram)
+			return
+		}
+	}
+	h := New()
+	h.Merge(e.Histogram)
+	e.Histogram = h
+	f.Entries = append(f.Entries, e)
+}
+
+// Merge 把多个文件中同名的直方图逐桶相加，条目按首次出现的顺序排列
+func Merge(files ...*File) *File {
+	out := &File{CreatedAt: time.Now()}
+	for _, f := range files {
+		for _, e := range f.Entries {
+			out.Add(e)
+		}
+	}
+	return out
+}
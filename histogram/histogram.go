@@ -0,0 +1,202 @@
+// Package histogram 实现 HDR 风格的对数-线性延迟直方图：
+// 每个 2 的幂区间再线性划分为 64 个子桶，任意值的相对误差不超过 1/64 (约 1.6%)，
+// 记录一次只是一次数组自增，同一配置的直方图可以直接逐桶相加合并
+package histogram
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"time"
+)
+
+const (
+	// subBits 决定精度：小于 2^subBits 的值精确记录，更大的值保留最高 subBits 位
+	subBits  = 7
+	subCount = 1 << subBits
+	subHalf  = subCount / 2
+)
+
+// Histogram 记录非负整数值 (通常是纳秒) 的分布，零值可以直接使用
+type Histogram struct {
+	counts []uint64
+	total  uint64
+	min    int64
+	max    int64
+	sum    float64
+}
+
+// New 返回空直方图
+func New() *Histogram {
+	return &Histogram{}
+}
+
+// index 返回 v 所在桶的下标
+func index(v int64) int {
+	if v < subCount {
+		return int(v)
+	}
+	shift := bits.Len64(uint64(v)) - subBits
+	sub := int(v >> shift)
+	return subCount + (shift-1)*subHalf + sub - subHalf
+}
+
+// bounds 返回桶 i 覆盖的闭区间 [lo, hi]
+func bounds(i int) (lo, hi int64) {
+	if i < subCount {
+		return int64(i), int64(i)
+	}
+	shift := (i-subCount)/subHalf + 1
+	sub := int64((i-subCount)%subHalf + subHalf)
+	return sub << shift, (sub+1)<<shift - 1
+}
+
+// Record 记录一个值，负数按 0 处理
+func (h *Histogram) Record(v int64) {
+	h.RecordN(v, 1)
+}
+
+// RecordDuration 以纳秒记录一个时长
+func (h *Histogram) RecordDuration(d time.Duration) {
+	h.RecordN(d.Nanoseconds(), 1)
+}
+
+// RecordN 记录 n 个相同的值
+func (h *Histogram) RecordN(v int64, n uint64) {
+	if n == 0 {
+		return
+	}
+	v = max(v, 0)
+	i := index(v)
+	if i >= len(h.counts) {
+		h.counts = append(h.counts, make([]uint64, i+1-len(h.counts))...)
+	}
+	h.counts[i] += n
+	if h.total == 0 || v < h.min {
+		h.min = v
+	}
+	h.max = max(h.max, v)
+	h.total += n
+	h.sum += float64(v) * float64(n)
+}
+
+// Merge 把 o 的全部记录加入 h
+func (h *Histogram) Merge(o *Histogram) {
+	if o == nil || o.total == 0 {
+		return
+	}
+	if len(o.counts) > len(h.counts) {
+		h.counts = append(h.counts, make([]uint64, len(o.counts)-len(h.counts))...)
+	}
+	for i, n := range o.counts {
+		h.counts[i] += n
+	}
+	if h.total == 0 || o.min < h.min {
+		h.min = o.min
+	}
+	h.max = max(h.max, o.max)
+	h.total += o.total
+	h.sum += o.sum
+}
+
+// Count 返回记录的值的个数
+func (h *Histogram) Count() uint64 { return h.total }
+
+// Min 返回最小值，没有记录时为 0
+func (h *Histogram) Min() int64 { return h.min }
+
+// Max 返回最大值，没有记录时为 0
+func (h *Histogram) Max() int64 { return h.max }
+
+// Mean 返回精确的平均值
+func (h *Histogram) Mean() float64 {
+	if h.total == 0 {
+		return 0
+	}
+	return h.sum / float64(h.total)
+}
+
+// Quantile 返回分位数 q (0-1) 处的值，取所在桶的上界并限制在 [Min, Max] 内，
+// 因此不会低估尾延迟。没有记录时为 0
+func (h *Histogram) Quantile(q float64) int64 {
+	if h.total == 0 {
+		return 0
+	}
+	rank := uint64(math.Ceil(q * float64(h.total)))
+	rank = min(max(rank, 1), h.total)
+	var seen uint64
+	for i, n := range h.counts {
+		seen += n
+		if seen >= rank {
+			_, hi := bounds(i)
+			return min(max(hi, h.min), h.max)
+		}
+	}
+	return h.max
+}
+
+// Percentiles 是报告中输出的分位数
+var Percentiles = []float64{0.50, 0.95, 0.99, 0.999}
+
+// PercentileLabel 返回分位数的名称，例如 0.999 -> p999
+func PercentileLabel(q float64) string {
+	s := strconv.FormatFloat(math.Round(q*1e4)/1e2, 'f', -1, 64)
+	out := []byte{'p'}
+	for i := 0; i < len(s); i++ {
+		if s[i] != '.' {
+			out = append(out, s[i])
+		}
+	}
+	return string(out)
+}
+
+// snapshot 是直方图的 JSON 形式，只保存非空桶
+type snapshot struct {
+	SubBits int         `json:"sub_bits"`
+	Total   uint64      `json:"total"`
+	Min     int64       `json:"min"`
+	Max     int64       `json:"max"`
+	Sum     float64     `json:"sum"`
+	Buckets [][2]uint64 `json:"buckets"`
+}
+
+// MarshalJSON 以稀疏的 [下标, 次数] 列表保存非空桶
+func (h *Histogram) MarshalJSON() ([]byte, error) {
+	s := snapshot{SubBits: subBits, Total: h.total, Min: h.min, Max: h.max, Sum: h.sum, Buckets: [][2]uint64{}}
+	for i, n := range h.counts {
+		if n > 0 {
+			s.Buckets = append(s.Buckets, [2]uint64{uint64(i), n})
+		}
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON 读取 MarshalJSON 的输出，精度配置不同的直方图无法合并，直接报错
+func (h *Histogram) UnmarshalJSON(data []byte) error {
+	var s snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s.SubBits != subBits {
+		return fmt.Errorf("histogram: sub_bits %d, want %d", s.SubBits, subBits)
+	}
+	*h = Histogram{min: s.Min, max: s.Max, total: s.Total, sum: s.Sum}
+	var total uint64
+	for _, b := range s.Buckets {
+		i := int(b[0])
+		if b[0] > uint64(index(math.MaxInt64)) {
+			return fmt.Errorf("histogram: bucket %d out of range", b[0])
+		}
+		if i >= len(h.counts) {
+			h.counts = append(h.counts, make([]uint64, i+1-len(h.counts))...)
+		}
+		h.counts[i] += b[1]
+		total += b[1]
+	}
+	if total != s.Total {
+		return fmt.Errorf("histogram: buckets sum to %d, total is %d", total, s.Total)
+	}
+	return nil
+}
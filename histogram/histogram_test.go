@@ -0,0 +1,92 @@
+package histogram
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestBucketRoundTrip(t *testing.T) {
+	for _, v := range []int64{0, 1, 127, 128, 129, 255, 256, 1000, 123456789, 1 << 40} {
+		lo, hi := bounds(index(v))
+		if v < lo || v > hi {
+			t.Errorf("%d not in bucket [%d, %d]", v, lo, hi)
+		}
+		if float64(hi-lo) > float64(v)/subHalf {
+			t.Errorf("bucket [%d, %d] too wide for %d", lo, hi, v)
+		}
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	h := New()
+	var xs []int64
+	for i := 0; i < 100000; i++ {
+		v := int64(r.ExpFloat64() * 50000)
+		xs = append(xs, v)
+		h.Record(v)
+	}
+	slices.Sort(xs)
+	for _, q := range Percentiles {
+		exact := xs[int(q*float64(len(xs)))-1]
+		got := h.Quantile(q)
+		if got < exact || float64(got-exact) > float64(exact)/subHalf+1 {
+			t.Errorf("%s = %d, exact %d", PercentileLabel(q), got, exact)
+		}
+	}
+	if h.Quantile(1) != xs[len(xs)-1] || h.Min() != xs[0] {
+		t.Errorf("min/max = %d/%d, want %d/%d", h.Min(), h.Quantile(1), xs[0], xs[len(xs)-1])
+	}
+}
+
+func TestMergeAndFile(t *testing.T) {
+	a, b, all := New(), New(), New()
+	for i := int64(1); i <= 1000; i++ {
+		all.Record(i * 1000)
+		if i%2 == 0 {
+			a.Record(i * 1000)
+		} else {
+			b.Record(i * 1000)
+		}
+	}
+	dir := t.TempDir()
+	fa := &File{Entries: []Entry{{Name: "JormFindByID", ORM: "jorm", Scenario: "FindByID", Histogram: a}}}
+	fb := &File{Entries: []Entry{{Name: "JormFindByID", ORM: "jorm", Scenario: "FindByID", Histogram: b}}}
+	for name, f := range map[string]*File{"a.json": fa, "b.json": fb} {
+		if err := f.Save(filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	la, err := Load(filepath.Join(dir, "a.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lb, err := Load(filepath.Join(dir, "b.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged := Merge(la, lb)
+	if len(merged.Entries) != 1 {
+		t.Fatalf("got %d entries", len(merged.Entries))
+	}
+	got, _ := json.Marshal(merged.Entries[0].Histogram)
+	want, _ := json.Marshal(all)
+	if string(got) != string(want) {
+		t.Errorf("merged = %s\nwant %s", got, want)
+	}
+	// 合并不修改输入
+	if la.Entries[0].Histogram.Count() != 500 {
+		t.Errorf("input modified: count %d", la.Entries[0].Histogram.Count())
+	}
+}
+
+func TestPercentileLabel(t *testing.T) {
+	for q, want := range map[float64]string{0.5: "p50", 0.95: "p95", 0.99: "p99", 0.999: "p999", 0.9999: "p9999"} {
+		if got := PercentileLabel(q); got != want {
+			t.Errorf("PercentileLabel(%v) = %q, want %q", q, got, want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package histogram
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteTable 输出每个条目的样本数、均值、各分位数与最大值 (微秒)，
+// 同一场景的 ORM 相邻排列，便于对比尾延迟
+func WriteTable(w io.Writer, entries []Entry) error {
+	bw := bufio.NewWriter(w)
+	cols := []string{"操作类型", "ORM 框架", "次数", "平均 (µs)"}
+	for _, q := range Percentiles {
+		cols = append(cols, PercentileLabel(q)+" (µs)")
+	}
+	cols = append(cols, "最大 (µs)")
+	fmt.Fprintln(bw, "| "+strings.Join(cols, " | ")+" |")
+	fmt.Fprintln(bw, "|"+strings.Repeat("-----|", len(cols)))
+
+	var scenarios []string
+	byScenario := make(map[string][]Entry)
+	for _, e := range entries {
+		if _, ok := byScenario[e.Scenario]; !ok {
+			scenarios = append(scenarios, e.Scenario)
+		}
+		byScenario[e.Scenario] = append(byScenario[e.Scenario], e)
+	}
+	for _, s := range scenarios {
+		for _, e := range byScenario[s] {
+			h := e.Histogram
+			row := []string{s, strings.ToUpper(e.ORM), fmt.Sprint(h.Count()), Micros(h.Mean())}
+			for _, q := range Percentiles {
+				row = append(row, Micros(float64(h.Quantile(q))))
+			}
+			row = append(row, Micros(float64(h.Max())))
+			fmt.Fprintln(bw, "| "+strings.Join(row, " | ")+" |")
+		}
+	}
+	return bw.Flush()
+}
+
+// Metrics 返回直方图的分位数指标，例如 p99-ns，附加在 benchmark 结果中
+func Metrics(h *Histogram) map[string]float64 {
+	m := make(map[string]float64, len(Percentiles)+1)
+	for _, q := range Percentiles {
+		m[PercentileLabel(q)+"-ns"] = float64(h.Quantile(q))
+	}
+	m["max-ns"] = float64(h.Max())
+	return m
+}
+
+// Micros 把纳秒格式化为保留一位小数的微秒
+func Micros(ns float64) string {
+	return fmt.Sprintf("%.1f", ns/1e3)
+}
@@ -0,0 +1,240 @@
+package logger_bench
+
+import (
+	"fmt"
+	"io"
+	stdlog "log"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/shrek82/jorm"
+	"github.com/shrek82/jorm/logger"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"xorm.io/xorm"
+	xormlog "xorm.io/xorm/log"
+
+	"goapi/sqlcount/sqlcounttest"
+)
+
+// logLevel 把同一档日志级别映射到三种 ORM 各自的配置。
+// xorm 只有开启 ShowSQL 时才记录 SQL，因此只在 info 级别开启
+type logLevel struct {
+	name    string
+	jorm    logger.LogLevel
+	gorm    gormlogger.LogLevel
+	xorm    xormlog.LogLevel
+	showSQL bool
+}
+
+var logLevels = []logLevel{
+	{"silent", logger.LogLevelSilent, gormlogger.Silent, xormlog.LOG_OFF, false},
+	{"error", logger.LogLevelError, gormlogger.Error, xormlog.LOG_ERR, false},
+	{"warn", logger.LogLevelWarn, gormlogger.Warn, xormlog.LOG_WARNING, false},
+	{"info", logger.LogLevelInfo, gormlogger.Info, xormlog.LOG_INFO, true},
+}
+
+// gorm 与 xorm 只有文本格式
+var (
+	jormFormats = []logger.LogFormat{logger.LogFormatText, logger.LogFormatJSON}
+	textOnly    = []logger.LogFormat{logger.LogFormatText}
+)
+
+// opener 按日志级别与格式打开一个 ORM，返回单次操作与关闭函数
+type opener func(b *testing.B, l logLevel, f logger.LogFormat) (op func(i int), done func())
+
+// allocStats 是计时区间内每次操作的分配字节数与分配次数
+type allocStats struct {
+	bytes, allocs float64
+}
+
+// setupTestData 在每个 benchmark 开始前准备测试数据，count 为 0 时只清空表
+func setupTestData(b *testing.B, count int) {
+	b.Helper()
+	t, err := benchTarget()
+	if err != nil {
+		b.Fatalf("load config: %v", err)
+	}
+	if err := t.Seed(count); err != nil {
+		b.Fatalf("seed users: %v", err)
+	}
+}
+
+// warmUp 在计时之前执行一次 op，建立连接并缓存语句与模型元数据
+func warmUp(op func(i int)) {
+	op(0)
+}
+
+// benchmarkLog 对每个日志级别与格式运行一个子 benchmark，日志写入 io.Discard。
+// 除常规指标外上报 log-B/op 与 log-allocs/op：计时区间内每次操作的分配减去同一 ORM、同一格式 silent 子 benchmark 的分配，
+// 即日志本身的开销。差值不截断，接近 0 的负值说明测量噪声大于日志开销；只运行部分子 benchmark、没有 silent 结果时不上报
+func benchmarkLog(b *testing.B, rows int, formats []logger.LogFormat, open opener) {
+	silent := map[logger.LogFormat]allocStats{}
+	for _, l := range logLevels {
+		for _, f := range formats {
+			b.Run(fmt.Sprintf("level=%s/format=%s", l.name, f), func(b *testing.B) {
+				setupTestData(b, rows)
+				op, done := open(b, l, f)
+				defer done()
+				warmUp(op)
+				// 预热可能插入了数据，重新准备
+				setupTestData(b, rows)
+
+				var before, after runtime.MemStats
+				runtime.ReadMemStats(&before)
+				b.ResetTimer()
+				defer sqlcounttest.Measure(b)()
+				for i := 0; i < b.N; i++ {
+					op(i)
+				}
+				b.StopTimer()
+				runtime.ReadMemStats(&after)
+				n := float64(b.N)
+				got := allocStats{
+					bytes:  float64(after.TotalAlloc-before.TotalAlloc) / n,
+					allocs: float64(after.Mallocs-before.Mallocs) / n,
+				}
+				if l.name == logLevels[0].name {
+					silent[f] = got
+				}
+				if base, ok := silent[f]; ok {
+					b.ReportMetric(got.bytes-base.bytes, "log-B/op")
+					b.ReportMetric(got.allocs-base.allocs, "log-allocs/op")
+				}
+			})
+		}
+	}
+}
+
+func openJorm(b *testing.B, l logLevel, f logger.LogFormat) *jorm.DB {
+	b.Helper()
+	engine, err := NewJormEngine()
+	if err != nil {
+		b.Fatalf("new jorm engine: %v", err)
+	}
+	// 与 main.go 中的用法一致，只是输出到 io.Discard
+	lg := logger.NewStdLogger()
+	lg.SetLevel(l.jorm)
+	lg.SetFormat(f)
+	lg.SetOutput(io.Discard)
+	engine.SetLogger(lg)
+	return engine
+}
+
+func openGorm(b *testing.B, l logLevel) (*gorm.DB, func()) {
+	b.Helper()
+	db, err := NewGormDB()
+	if err != nil {
+		b.Fatalf("new gorm db: %v", err)
+	}
+	db.Logger = gormlogger.New(stdlog.New(io.Discard, "\r\n", stdlog.LstdFlags), gormlogger.Config{
+		SlowThreshold: 200 * time.Millisecond,
+		LogLevel:      l.gorm,
+	})
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("gorm db.DB(): %v", err)
+	}
+	return db, func() { sqlDB.Close() }
+}
+
+func openXorm(b *testing.B, l logLevel) *xorm.Engine {
+	b.Helper()
+	engine, err := NewXormEngine()
+	if err != nil {
+		b.Fatalf("new xorm engine: %v", err)
+	}
+	engine.SetLogger(xormlog.NewSimpleLogger(io.Discard))
+	engine.SetLogLevel(l.xorm)
+	engine.ShowSQL(l.showSQL)
+	return engine
+}
+
+// BenchmarkJormLogFindByID 测试 jorm 各日志级别与格式下按 ID 查询的开销
+func BenchmarkJormLogFindByID(b *testing.B) {
+	benchmarkLog(b, 1000, jormFormats, func(b *testing.B, l logLevel, f logger.LogFormat) (func(int), func()) {
+		engine := openJorm(b, l, f)
+		return func(i int) {
+			var user User
+			if err := engine.Model(&user).Where("id = ?", int64(i%1000+1)).First(&user); err != nil {
+				b.Fatalf("jorm find: %v", err)
+			}
+		}, func() { engine.Close() }
+	})
+}
+
+// BenchmarkGormLogFindByID 测试 gorm 各日志级别下按 ID 查询的开销
+func BenchmarkGormLogFindByID(b *testing.B) {
+	benchmarkLog(b, 1000, textOnly, func(b *testing.B, l logLevel, _ logger.LogFormat) (func(int), func()) {
+		db, done := openGorm(b, l)
+		return func(i int) {
+			var user User
+			if err := db.Where("id = ?", int64(i%1000+1)).First(&user).Error; err != nil {
+				b.Fatalf("gorm find: %v", err)
+			}
+		}, done
+	})
+}
+
+// BenchmarkXormLogFindByID 测试 xorm 各日志级别 (info 级别开启 ShowSQL) 下按 ID 查询的开销
+func BenchmarkXormLogFindByID(b *testing.B) {
+	benchmarkLog(b, 1000, textOnly, func(b *testing.B, l logLevel, _ logger.LogFormat) (func(int), func()) {
+		engine := openXorm(b, l)
+		return func(i int) {
+			var user User
+			id := int64(i%1000 + 1)
+			has, err := engine.ID(id).Get(&user)
+			if err != nil {
+				b.Fatalf("xorm find: %v", err)
+			}
+			if !has {
+				b.Fatalf("user not found: %d", id)
+			}
+		}, func() { engine.Close() }
+	})
+}
+
+// prepareUser 生成一条测试数据，index 用于避免完全相同的数据
+func prepareUser(index int) *User {
+	return &User{
+		Name: fmt.Sprintf("user_%d", index),
+		Age:  20 + index%30,
+	}
+}
+
+// BenchmarkJormLogInsert 测试 jorm 各日志级别与格式下插入的开销
+func BenchmarkJormLogInsert(b *testing.B) {
+	benchmarkLog(b, 0, jormFormats, func(b *testing.B, l logLevel, f logger.LogFormat) (func(int), func()) {
+		engine := openJorm(b, l, f)
+		return func(i int) {
+			if _, err := engine.Model(&User{}).Insert(prepareUser(i)); err != nil {
+				b.Fatalf("jorm insert: %v", err)
+			}
+		}, func() { engine.Close() }
+	})
+}
+
+// BenchmarkGormLogInsert 测试 gorm 各日志级别下插入的开销
+func BenchmarkGormLogInsert(b *testing.B) {
+	benchmarkLog(b, 0, textOnly, func(b *testing.B, l logLevel, _ logger.LogFormat) (func(int), func()) {
+		db, done := openGorm(b, l)
+		return func(i int) {
+			if err := db.Create(prepareUser(i)).Error; err != nil {
+				b.Fatalf("gorm insert: %v", err)
+			}
+		}, done
+	})
+}
+
+// BenchmarkXormLogInsert 测试 xorm 各日志级别下插入的开销
+func BenchmarkXormLogInsert(b *testing.B) {
+	benchmarkLog(b, 0, textOnly, func(b *testing.B, l logLevel, _ logger.LogFormat) (func(int), func()) {
+		engine := openXorm(b, l)
+		return func(i int) {
+			if _, err := engine.Insert(prepareUser(i)); err != nil {
+				b.Fatalf("xorm insert: %v", err)
+			}
+		}, func() { engine.Close() }
+	})
+}
@@ -1,87 +1,69 @@
+// jormbench 是 jorm / gorm / xorm 性能对比的命令行入口，不需要了解 go test 也能产出结果：
+//
+//	jormbench seed -rows 5000                        预置 users 表数据
+//	jormbench run -scenarios FindByID,Insert -out r.json
+//	jormbench report -in r.json -readme jorm_readme.md
+//	jormbench compare baseline.json r.json          出现显著回归时以非 0 状态码退出
+//
+// 每个子命令的参数见 jormbench <command> -h
 package main
 
 import (
+	"fmt"
 	"log"
-	"time"
-
-	_ "github.com/go-sql-driver/mysql"
-	_ "github.com/mattn/go-sqlite3"
-	"github.com/shrek82/jorm"
-	"github.com/shrek82/jorm/logger"
+	"os"
 )
 
-type User struct {
-	ID        int64     `jorm:"pk;auto"`
-	Name      string    `jorm:"column:username"`
-	Age       int       `jorm:"column:age"`
-	CreatedAt time.Time `jorm:"auto_time"`
-	UpdatedAt time.Time `jorm:"auto_update"`
+// command 是一个子命令
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
 }
 
-func (u *User) TableName() string {
-	return "users"
+var commands = []command{
+	{"run", "在 go test 之外按 ORM / 数据量 / 存储模式运行场景", runRun},
+	{"seed", "建表并预置测试数据", runSeed},
+	{"report", "把结果渲染为 markdown 对比表", runReport},
+	{"latency", "记录每次操作的延迟直方图，输出 p50 / p95 / p99 / p999", runLatency},
+	{"rate", "以固定速率开环施压，逐级加压找出每个 ORM 的饱和点", runRate},
+	{"gc", "在一组 GOGC / GOMEMLIMIT 设置下运行场景，对比 GC 次数、停顿与堆峰值", runGC},
+	{"workload", "运行 YCSB 风格的混合读写负载 (A-F)", runWorkload},
+	{"isolate", "每个 benchmark 的每次重复都在独立进程中按随机顺序运行", runIsolate},
+	{"compare", "对比两个结果文件，出现显著回归时以非 0 状态码退出", runCompare},
+	{"versions", "用多个 jorm 版本运行同一组 go test benchmark", runVersions},
+	{"profile", "按场景采集 CPU / 内存 profile 并与最好的 ORM 对比", runProfile},
+	{"genmodels", "生成大量带三种 tag 的模型结构体 (go generate 使用)", runGenModels},
+	{"genbench", "按 YAML / JSON 场景描述生成各 ORM 的 benchmark (go generate 使用)", runGenBench},
 }
 
 func main() {
-	// SQLite 数据库文件路径
-	//dsn := "test.db"
-
-	engine, err := jorm.Open("mysql", "root@tcp(127.0.0.1:3306)/jorm?charset=utf8mb4", nil)
-	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+	log.SetFlags(0)
+	log.SetPrefix("jormbench: ")
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
 	}
-	customLogger := logger.NewStdLogger()
-	customLogger.SetLevel(logger.LogLevelInfo)
-	customLogger.SetFormat(logger.LogFormatText)
-	engine.SetLogger(customLogger)
-
-	defer engine.Close()
-
-	// 1. 自动迁移 (创建表)
-	err = engine.AutoMigrate(&User{})
-	if err != nil {
-		log.Fatalf("AutoMigrate failed: %v", err)
+	name := os.Args[1]
+	for _, c := range commands {
+		if c.name == name {
+			if err := c.run(os.Args[2:]); err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
 	}
-
-	//删除所有数据
-	engine.Model(&User{}).Where("id > ?", 0).Delete()
-
-	// 2. 插入用户示例
-	newUser := &User{
-		Name: "张三",
-		Age:  28,
-	}
-
-	_, err = engine.Model(newUser).Insert(newUser)
-	if err != nil {
-		log.Printf("插入用户失败: %v", err)
-	} else {
-		log.Printf("成功插入用户，ID: %d", newUser.ID)
-	}
-
-	// 3. 查询用户示例
-	var user User
-	err = engine.Model(&User{}).Where("id = ?", newUser.ID).First(&user)
-	if err != nil {
-		log.Printf("查询用户失败: %v", err)
-	} else {
-		log.Printf("查询到用户: %+v", user)
-	}
-
-	// 4. 更新用户示例
-	user.Age = 29
-	rows, err := engine.Model(&user).Where("id = ?", user.ID).Update(user)
-	if err != nil {
-		log.Printf("更新用户失败: %v", err)
-	} else {
-		log.Printf("成功更新用户，影响行数: %d", rows)
+	if name != "help" && name != "-h" && name != "--help" {
+		fmt.Fprintf(os.Stderr, "jormbench: unknown command %q\n\n", name)
 	}
+	usage()
+	os.Exit(2)
+}
 
-	// 5. 删除用户示例
-	rows, err = engine.Model(&User{}).Where("id = ?", user.ID).Delete()
-	if err != nil {
-		log.Printf("删除用户失败: %v", err)
-	} else {
-		log.Printf("成功删除用户，影响行数: %d", rows)
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: jormbench <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-9s %s\n", c.name, c.usage)
 	}
 }
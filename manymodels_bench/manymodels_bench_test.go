@@ -0,0 +1,373 @@
+package manymodels_bench
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"goapi/sqlcount/sqlcounttest"
+)
+
+// setupOnce 为每个模型建表并插入 id = 1 的一行，表保留在数据库中，之后的运行直接跳过。
+// 用一个临时的 gorm DB 建表：gorm 的 schema 缓存属于这个 DB，不会预热被测引擎
+var setupOnce = sync.OnceValue(func() error {
+	db, err := NewGormDB()
+	if err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+	for _, newModel := range Models {
+		m := newModel()
+		if db.Migrator().HasTable(m) {
+			continue
+		}
+		if err := db.AutoMigrate(m); err != nil {
+			return err
+		}
+		if err := db.Create(m).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+})
+
+func setupModels(b *testing.B) {
+	b.Helper()
+	if err := setupOnce(); err != nil {
+		b.Fatalf("create model tables: %v", err)
+	}
+}
+
+// metaStats 在计时之外测量每轮首次使用全部模型后常驻堆的增长，即元数据缓存的内存占用
+type metaStats struct {
+	mem   runtime.MemStats
+	heap  uint64
+	bytes int64
+}
+
+func (s *metaStats) before(b *testing.B) {
+	b.StopTimer()
+	runtime.GC()
+	runtime.ReadMemStats(&s.mem)
+	s.heap = s.mem.HeapAlloc
+	b.StartTimer()
+}
+
+func (s *metaStats) after(b *testing.B) {
+	b.StopTimer()
+	runtime.GC()
+	runtime.ReadMemStats(&s.mem)
+	s.bytes += int64(s.mem.HeapAlloc) - int64(s.heap)
+}
+
+// report 上报每个模型的首次使用耗时 ns/model 与元数据内存 meta-B/model
+func (s *metaStats) report(b *testing.B) {
+	n := float64(b.N * len(Models))
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/n, "ns/model")
+	b.ReportMetric(float64(s.bytes)/n, "meta-B/model")
+}
+
+// BenchmarkJormRoundRobin 测试 jorm 在 300 个模型之间轮流按 ID 查询，元数据都已缓存
+func BenchmarkJormRoundRobin(b *testing.B) {
+	setupModels(b)
+
+	engine, err := NewJormEngine()
+	if err != nil {
+		b.Fatalf("new jorm engine: %v", err)
+	}
+	defer engine.Close()
+	for _, newModel := range Models {
+		m := newModel()
+		if err := engine.Model(m).Where("id = ?", 1).First(m); err != nil {
+			b.Fatalf("jorm warm up: %v", err)
+		}
+	}
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		m := Models[i%len(Models)]()
+		if err := engine.Model(m).Where("id = ?", 1).First(m); err != nil {
+			b.Fatalf("jorm find: %v", err)
+		}
+	}
+}
+
+// BenchmarkGormRoundRobin 测试 gorm 在 300 个模型之间轮流按 ID 查询
+func BenchmarkGormRoundRobin(b *testing.B) {
+	setupModels(b)
+
+	db, err := NewGormDB()
+	if err != nil {
+		b.Fatalf("new gorm db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("gorm db.DB(): %v", err)
+	}
+	defer sqlDB.Close()
+	for _, newModel := range Models {
+		if err := db.Where("id = ?", 1).First(newModel()).Error; err != nil {
+			b.Fatalf("gorm warm up: %v", err)
+		}
+	}
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		if err := db.Where("id = ?", 1).First(Models[i%len(Models)]()).Error; err != nil {
+			b.Fatalf("gorm find: %v", err)
+		}
+	}
+}
+
+// BenchmarkXormRoundRobin 测试 xorm 在 300 个模型之间轮流按 ID 查询
+func BenchmarkXormRoundRobin(b *testing.B) {
+	setupModels(b)
+
+	engine, err := NewXormEngine()
+	if err != nil {
+		b.Fatalf("new xorm engine: %v", err)
+	}
+	defer engine.Close()
+	for _, newModel := range Models {
+		if _, err := engine.ID(1).Get(newModel()); err != nil {
+			b.Fatalf("xorm warm up: %v", err)
+		}
+	}
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		has, err := engine.ID(1).Get(Models[i%len(Models)]())
+		if err != nil {
+			b.Fatalf("xorm find: %v", err)
+		}
+		if !has {
+			b.Fatalf("xorm find: row not found")
+		}
+	}
+}
+
+// parallelism 是并发查找时每个 CPU 的 goroutine 数，单核机器上也能制造缓存查找的竞争
+const parallelism = 8
+
+// BenchmarkJormRoundRobinParallel 测试多个 goroutine 同时在 300 个模型之间轮流查询时 jorm 元数据缓存的竞争
+func BenchmarkJormRoundRobinParallel(b *testing.B) {
+	setupModels(b)
+
+	engine, err := NewJormEngine()
+	if err != nil {
+		b.Fatalf("new jorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.SetParallelism(parallelism)
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			m := Models[i%len(Models)]()
+			if err := engine.Model(m).Where("id = ?", 1).First(m); err != nil {
+				b.Errorf("jorm find: %v", err)
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkGormRoundRobinParallel 测试并发轮流查询时 gorm schema 缓存的竞争
+func BenchmarkGormRoundRobinParallel(b *testing.B) {
+	setupModels(b)
+
+	db, err := NewGormDB()
+	if err != nil {
+		b.Fatalf("new gorm db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("gorm db.DB(): %v", err)
+	}
+	defer sqlDB.Close()
+
+	b.SetParallelism(parallelism)
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			if err := db.Where("id = ?", 1).First(Models[i%len(Models)]()).Error; err != nil {
+				b.Errorf("gorm find: %v", err)
+				return
+			}
+		}
+	})
+}
+
+// BenchmarkXormRoundRobinParallel 测试并发轮流查询时 xorm 表信息缓存的竞争
+func BenchmarkXormRoundRobinParallel(b *testing.B) {
+	setupModels(b)
+
+	engine, err := NewXormEngine()
+	if err != nil {
+		b.Fatalf("new xorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.SetParallelism(parallelism)
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			if _, err := engine.ID(1).Get(Models[i%len(Models)]()); err != nil {
+				b.Errorf("xorm find: %v", err)
+				return
+			}
+		}
+	})
+}
+
+// firstUseChildEnv 非空时 TestJormFirstUseChild 执行一次首次使用并输出测量结果
+const firstUseChildEnv = "MANYMODELS_FIRSTUSE_CHILD"
+
+// firstUse 是一个子进程中把全部模型各查询一次的耗时、分配与常驻堆增长
+type firstUse struct {
+	ns, bytes, allocs, meta int64
+}
+
+// TestJormFirstUseChild 是 BenchmarkJormFirstUse 启动的子进程：在没有用过任何模型的新进程中
+// 把 300 个模型各查询一次，以 "firstuse ns bytes allocs meta" 一行输出测量结果
+func TestJormFirstUseChild(t *testing.T) {
+	if os.Getenv(firstUseChildEnv) == "" {
+		t.Skip("started by BenchmarkJormFirstUse")
+	}
+	if err := setupOnce(); err != nil {
+		t.Fatalf("create model tables: %v", err)
+	}
+	engine, err := NewJormEngine()
+	if err != nil {
+		t.Fatalf("new jorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	var before, after, live runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	for _, newModel := range Models {
+		m := newModel()
+		if err := engine.Model(m).Where("id = ?", 1).First(m); err != nil {
+			t.Fatalf("jorm find: %v", err)
+		}
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+	runtime.GC()
+	runtime.ReadMemStats(&live)
+	fmt.Printf("firstuse %d %d %d %d\n", elapsed.Nanoseconds(),
+		after.TotalAlloc-before.TotalAlloc, after.Mallocs-before.Mallocs, int64(live.HeapAlloc)-int64(before.HeapAlloc))
+}
+
+// runFirstUseChild 在新进程中运行 TestJormFirstUseChild 并解析它的测量结果
+func runFirstUseChild() (firstUse, error) {
+	cmd := exec.Command(os.Args[0], "-test.run=^TestJormFirstUseChild$", "-test.count=1")
+	cmd.Env = append(os.Environ(), firstUseChildEnv+"=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return firstUse{}, fmt.Errorf("%w\n%s", err, out)
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		var r firstUse
+		if _, err := fmt.Sscanf(line, "firstuse %d %d %d %d", &r.ns, &r.bytes, &r.allocs, &r.meta); err == nil {
+			return r, nil
+		}
+	}
+	return firstUse{}, fmt.Errorf("no firstuse line in child output:\n%s", out)
+}
+
+// BenchmarkJormFirstUse 每次迭代在新进程中把 300 个模型各查询一次，上报 ns/model 与 meta-B/model。
+// jorm 的模型缓存是进程级的，同一进程中只有第一次使用是冷的，因此每次迭代由 runFirstUseChild 启动子进程；
+// ns/op、B/op、allocs/op 取子进程内测量的值，不含启动进程与建立连接，与 gorm / xorm 的每次迭代可比
+func BenchmarkJormFirstUse(b *testing.B) {
+	setupModels(b)
+
+	var total firstUse
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r, err := runFirstUseChild()
+		if err != nil {
+			b.Fatalf("jorm first use: %v", err)
+		}
+		total.ns += r.ns
+		total.bytes += r.bytes
+		total.allocs += r.allocs
+		total.meta += r.meta
+	}
+	b.StopTimer()
+	n := float64(b.N)
+	b.ReportMetric(float64(total.ns)/n, "ns/op")
+	b.ReportMetric(float64(total.bytes)/n, "B/op")
+	b.ReportMetric(float64(total.allocs)/n, "allocs/op")
+	b.ReportMetric(float64(total.ns)/(n*float64(len(Models))), "ns/model")
+	b.ReportMetric(float64(total.meta)/(n*float64(len(Models))), "meta-B/model")
+}
+
+// BenchmarkGormFirstUse 每次迭代在新 DB 上把 300 个模型各查询一次，gorm 的 schema 缓存属于每个 DB
+func BenchmarkGormFirstUse(b *testing.B) {
+	setupModels(b)
+
+	var stats metaStats
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		db, err := NewGormDB()
+		if err != nil {
+			b.Fatalf("new gorm db: %v", err)
+		}
+		stats.before(b)
+		for _, newModel := range Models {
+			if err := db.Where("id = ?", 1).First(newModel()).Error; err != nil {
+				b.Fatalf("gorm find: %v", err)
+			}
+		}
+		stats.after(b)
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+		b.StartTimer()
+	}
+	stats.report(b)
+}
+
+// BenchmarkXormFirstUse 每次迭代在新引擎上把 300 个模型各查询一次，xorm 的表信息缓存属于每个引擎
+func BenchmarkXormFirstUse(b *testing.B) {
+	setupModels(b)
+
+	var stats metaStats
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		engine, err := NewXormEngine()
+		if err != nil {
+			b.Fatalf("new xorm engine: %v", err)
+		}
+		stats.before(b)
+		for _, newModel := range Models {
+			if _, err := engine.ID(1).Get(newModel()); err != nil {
+				b.Fatalf("xorm find: %v", err)
+			}
+		}
+		stats.after(b)
+		engine.Close()
+		b.StartTimer()
+	}
+	stats.report(b)
+}
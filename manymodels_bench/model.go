@@ -0,0 +1,5 @@
+// Package manymodels_bench 用 models_gen.go 中生成的 300 个模型轮流查询，
+// 测量三种 ORM 的元数据缓存在模型数量很多时的内存占用、并发查找与首次使用的开销
+package manymodels_bench
+
+//go:generate go run .. genmodels -n 300 -seed 1 -o models_gen.go
@@ -0,0 +1,4790 @@
+// Code generated by jormbench genmodels -n 300 -seed 1; DO NOT EDIT.
+
+package manymodels_bench
+
+// Model000 对应表 model_000
+type Model000 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int     `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model000) TableName() string { return "model_000" }
+
+// Model001 对应表 model_001
+type Model001 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model001) TableName() string { return "model_001" }
+
+// Model002 对应表 model_002
+type Model002 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model002) TableName() string { return "model_002" }
+
+// Model003 对应表 model_003
+type Model003 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model003) TableName() string { return "model_003" }
+
+// Model004 对应表 model_004
+type Model004 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model004) TableName() string { return "model_004" }
+
+// Model005 对应表 model_005
+type Model005 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model005) TableName() string { return "model_005" }
+
+// Model006 对应表 model_006
+type Model006 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model006) TableName() string { return "model_006" }
+
+// Model007 对应表 model_007
+type Model007 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model007) TableName() string { return "model_007" }
+
+// Model008 对应表 model_008
+type Model008 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model008) TableName() string { return "model_008" }
+
+// Model009 对应表 model_009
+type Model009 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model009) TableName() string { return "model_009" }
+
+// Model010 对应表 model_010
+type Model010 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model010) TableName() string { return "model_010" }
+
+// Model011 对应表 model_011
+type Model011 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model011) TableName() string { return "model_011" }
+
+// Model012 对应表 model_012
+type Model012 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model012) TableName() string { return "model_012" }
+
+// Model013 对应表 model_013
+type Model013 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int     `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model013) TableName() string { return "model_013" }
+
+// Model014 对应表 model_014
+type Model014 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model014) TableName() string { return "model_014" }
+
+// Model015 对应表 model_015
+type Model015 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model015) TableName() string { return "model_015" }
+
+// Model016 对应表 model_016
+type Model016 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model016) TableName() string { return "model_016" }
+
+// Model017 对应表 model_017
+type Model017 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model017) TableName() string { return "model_017" }
+
+// Model018 对应表 model_018
+type Model018 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model018) TableName() string { return "model_018" }
+
+// Model019 对应表 model_019
+type Model019 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 float64 `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 float64 `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model019) TableName() string { return "model_019" }
+
+// Model020 对应表 model_020
+type Model020 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model020) TableName() string { return "model_020" }
+
+// Model021 对应表 model_021
+type Model021 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model021) TableName() string { return "model_021" }
+
+// Model022 对应表 model_022
+type Model022 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int     `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model022) TableName() string { return "model_022" }
+
+// Model023 对应表 model_023
+type Model023 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model023) TableName() string { return "model_023" }
+
+// Model024 对应表 model_024
+type Model024 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model024) TableName() string { return "model_024" }
+
+// Model025 对应表 model_025
+type Model025 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model025) TableName() string { return "model_025" }
+
+// Model026 对应表 model_026
+type Model026 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model026) TableName() string { return "model_026" }
+
+// Model027 对应表 model_027
+type Model027 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model027) TableName() string { return "model_027" }
+
+// Model028 对应表 model_028
+type Model028 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model028) TableName() string { return "model_028" }
+
+// Model029 对应表 model_029
+type Model029 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model029) TableName() string { return "model_029" }
+
+// Model030 对应表 model_030
+type Model030 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model030) TableName() string { return "model_030" }
+
+// Model031 对应表 model_031
+type Model031 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model031) TableName() string { return "model_031" }
+
+// Model032 对应表 model_032
+type Model032 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int    `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model032) TableName() string { return "model_032" }
+
+// Model033 对应表 model_033
+type Model033 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model033) TableName() string { return "model_033" }
+
+// Model034 对应表 model_034
+type Model034 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int    `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int    `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int    `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model034) TableName() string { return "model_034" }
+
+// Model035 对应表 model_035
+type Model035 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int    `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model035) TableName() string { return "model_035" }
+
+// Model036 对应表 model_036
+type Model036 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model036) TableName() string { return "model_036" }
+
+// Model037 对应表 model_037
+type Model037 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model037) TableName() string { return "model_037" }
+
+// Model038 对应表 model_038
+type Model038 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model038) TableName() string { return "model_038" }
+
+// Model039 对应表 model_039
+type Model039 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model039) TableName() string { return "model_039" }
+
+// Model040 对应表 model_040
+type Model040 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model040) TableName() string { return "model_040" }
+
+// Model041 对应表 model_041
+type Model041 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model041) TableName() string { return "model_041" }
+
+// Model042 对应表 model_042
+type Model042 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model042) TableName() string { return "model_042" }
+
+// Model043 对应表 model_043
+type Model043 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model043) TableName() string { return "model_043" }
+
+// Model044 对应表 model_044
+type Model044 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model044) TableName() string { return "model_044" }
+
+// Model045 对应表 model_045
+type Model045 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model045) TableName() string { return "model_045" }
+
+// Model046 对应表 model_046
+type Model046 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model046) TableName() string { return "model_046" }
+
+// Model047 对应表 model_047
+type Model047 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model047) TableName() string { return "model_047" }
+
+// Model048 对应表 model_048
+type Model048 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model048) TableName() string { return "model_048" }
+
+// Model049 对应表 model_049
+type Model049 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model049) TableName() string { return "model_049" }
+
+// Model050 对应表 model_050
+type Model050 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model050) TableName() string { return "model_050" }
+
+// Model051 对应表 model_051
+type Model051 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 string  `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 float64 `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model051) TableName() string { return "model_051" }
+
+// Model052 对应表 model_052
+type Model052 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model052) TableName() string { return "model_052" }
+
+// Model053 对应表 model_053
+type Model053 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int    `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int    `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model053) TableName() string { return "model_053" }
+
+// Model054 对应表 model_054
+type Model054 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model054) TableName() string { return "model_054" }
+
+// Model055 对应表 model_055
+type Model055 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 float64 `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int64   `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model055) TableName() string { return "model_055" }
+
+// Model056 对应表 model_056
+type Model056 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 float64 `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int64   `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model056) TableName() string { return "model_056" }
+
+// Model057 对应表 model_057
+type Model057 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int    `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model057) TableName() string { return "model_057" }
+
+// Model058 对应表 model_058
+type Model058 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int     `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model058) TableName() string { return "model_058" }
+
+// Model059 对应表 model_059
+type Model059 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 float64 `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model059) TableName() string { return "model_059" }
+
+// Model060 对应表 model_060
+type Model060 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model060) TableName() string { return "model_060" }
+
+// Model061 对应表 model_061
+type Model061 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model061) TableName() string { return "model_061" }
+
+// Model062 对应表 model_062
+type Model062 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model062) TableName() string { return "model_062" }
+
+// Model063 对应表 model_063
+type Model063 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model063) TableName() string { return "model_063" }
+
+// Model064 对应表 model_064
+type Model064 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 string  `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model064) TableName() string { return "model_064" }
+
+// Model065 对应表 model_065
+type Model065 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model065) TableName() string { return "model_065" }
+
+// Model066 对应表 model_066
+type Model066 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model066) TableName() string { return "model_066" }
+
+// Model067 对应表 model_067
+type Model067 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model067) TableName() string { return "model_067" }
+
+// Model068 对应表 model_068
+type Model068 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model068) TableName() string { return "model_068" }
+
+// Model069 对应表 model_069
+type Model069 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int    `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int    `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model069) TableName() string { return "model_069" }
+
+// Model070 对应表 model_070
+type Model070 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model070) TableName() string { return "model_070" }
+
+// Model071 对应表 model_071
+type Model071 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int    `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model071) TableName() string { return "model_071" }
+
+// Model072 对应表 model_072
+type Model072 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model072) TableName() string { return "model_072" }
+
+// Model073 对应表 model_073
+type Model073 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 float64 `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int     `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model073) TableName() string { return "model_073" }
+
+// Model074 对应表 model_074
+type Model074 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int    `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model074) TableName() string { return "model_074" }
+
+// Model075 对应表 model_075
+type Model075 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model075) TableName() string { return "model_075" }
+
+// Model076 对应表 model_076
+type Model076 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model076) TableName() string { return "model_076" }
+
+// Model077 对应表 model_077
+type Model077 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model077) TableName() string { return "model_077" }
+
+// Model078 对应表 model_078
+type Model078 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model078) TableName() string { return "model_078" }
+
+// Model079 对应表 model_079
+type Model079 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 string  `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int64   `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model079) TableName() string { return "model_079" }
+
+// Model080 对应表 model_080
+type Model080 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 string  `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model080) TableName() string { return "model_080" }
+
+// Model081 对应表 model_081
+type Model081 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model081) TableName() string { return "model_081" }
+
+// Model082 对应表 model_082
+type Model082 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model082) TableName() string { return "model_082" }
+
+// Model083 对应表 model_083
+type Model083 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model083) TableName() string { return "model_083" }
+
+// Model084 对应表 model_084
+type Model084 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model084) TableName() string { return "model_084" }
+
+// Model085 对应表 model_085
+type Model085 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model085) TableName() string { return "model_085" }
+
+// Model086 对应表 model_086
+type Model086 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model086) TableName() string { return "model_086" }
+
+// Model087 对应表 model_087
+type Model087 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model087) TableName() string { return "model_087" }
+
+// Model088 对应表 model_088
+type Model088 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int    `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model088) TableName() string { return "model_088" }
+
+// Model089 对应表 model_089
+type Model089 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model089) TableName() string { return "model_089" }
+
+// Model090 对应表 model_090
+type Model090 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model090) TableName() string { return "model_090" }
+
+// Model091 对应表 model_091
+type Model091 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model091) TableName() string { return "model_091" }
+
+// Model092 对应表 model_092
+type Model092 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model092) TableName() string { return "model_092" }
+
+// Model093 对应表 model_093
+type Model093 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model093) TableName() string { return "model_093" }
+
+// Model094 对应表 model_094
+type Model094 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 float64 `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model094) TableName() string { return "model_094" }
+
+// Model095 对应表 model_095
+type Model095 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model095) TableName() string { return "model_095" }
+
+// Model096 对应表 model_096
+type Model096 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int    `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int    `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int    `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int    `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model096) TableName() string { return "model_096" }
+
+// Model097 对应表 model_097
+type Model097 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model097) TableName() string { return "model_097" }
+
+// Model098 对应表 model_098
+type Model098 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model098) TableName() string { return "model_098" }
+
+// Model099 对应表 model_099
+type Model099 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model099) TableName() string { return "model_099" }
+
+// Model100 对应表 model_100
+type Model100 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model100) TableName() string { return "model_100" }
+
+// Model101 对应表 model_101
+type Model101 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model101) TableName() string { return "model_101" }
+
+// Model102 对应表 model_102
+type Model102 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int    `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model102) TableName() string { return "model_102" }
+
+// Model103 对应表 model_103
+type Model103 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model103) TableName() string { return "model_103" }
+
+// Model104 对应表 model_104
+type Model104 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model104) TableName() string { return "model_104" }
+
+// Model105 对应表 model_105
+type Model105 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model105) TableName() string { return "model_105" }
+
+// Model106 对应表 model_106
+type Model106 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model106) TableName() string { return "model_106" }
+
+// Model107 对应表 model_107
+type Model107 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model107) TableName() string { return "model_107" }
+
+// Model108 对应表 model_108
+type Model108 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 float64 `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model108) TableName() string { return "model_108" }
+
+// Model109 对应表 model_109
+type Model109 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 string  `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int64   `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model109) TableName() string { return "model_109" }
+
+// Model110 对应表 model_110
+type Model110 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model110) TableName() string { return "model_110" }
+
+// Model111 对应表 model_111
+type Model111 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model111) TableName() string { return "model_111" }
+
+// Model112 对应表 model_112
+type Model112 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model112) TableName() string { return "model_112" }
+
+// Model113 对应表 model_113
+type Model113 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model113) TableName() string { return "model_113" }
+
+// Model114 对应表 model_114
+type Model114 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int    `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model114) TableName() string { return "model_114" }
+
+// Model115 对应表 model_115
+type Model115 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model115) TableName() string { return "model_115" }
+
+// Model116 对应表 model_116
+type Model116 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model116) TableName() string { return "model_116" }
+
+// Model117 对应表 model_117
+type Model117 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model117) TableName() string { return "model_117" }
+
+// Model118 对应表 model_118
+type Model118 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model118) TableName() string { return "model_118" }
+
+// Model119 对应表 model_119
+type Model119 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model119) TableName() string { return "model_119" }
+
+// Model120 对应表 model_120
+type Model120 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model120) TableName() string { return "model_120" }
+
+// Model121 对应表 model_121
+type Model121 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int64   `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model121) TableName() string { return "model_121" }
+
+// Model122 对应表 model_122
+type Model122 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model122) TableName() string { return "model_122" }
+
+// Model123 对应表 model_123
+type Model123 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model123) TableName() string { return "model_123" }
+
+// Model124 对应表 model_124
+type Model124 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model124) TableName() string { return "model_124" }
+
+// Model125 对应表 model_125
+type Model125 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model125) TableName() string { return "model_125" }
+
+// Model126 对应表 model_126
+type Model126 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 string  `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model126) TableName() string { return "model_126" }
+
+// Model127 对应表 model_127
+type Model127 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int    `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model127) TableName() string { return "model_127" }
+
+// Model128 对应表 model_128
+type Model128 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model128) TableName() string { return "model_128" }
+
+// Model129 对应表 model_129
+type Model129 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model129) TableName() string { return "model_129" }
+
+// Model130 对应表 model_130
+type Model130 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model130) TableName() string { return "model_130" }
+
+// Model131 对应表 model_131
+type Model131 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model131) TableName() string { return "model_131" }
+
+// Model132 对应表 model_132
+type Model132 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model132) TableName() string { return "model_132" }
+
+// Model133 对应表 model_133
+type Model133 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model133) TableName() string { return "model_133" }
+
+// Model134 对应表 model_134
+type Model134 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 string  `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model134) TableName() string { return "model_134" }
+
+// Model135 对应表 model_135
+type Model135 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model135) TableName() string { return "model_135" }
+
+// Model136 对应表 model_136
+type Model136 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model136) TableName() string { return "model_136" }
+
+// Model137 对应表 model_137
+type Model137 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model137) TableName() string { return "model_137" }
+
+// Model138 对应表 model_138
+type Model138 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model138) TableName() string { return "model_138" }
+
+// Model139 对应表 model_139
+type Model139 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model139) TableName() string { return "model_139" }
+
+// Model140 对应表 model_140
+type Model140 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model140) TableName() string { return "model_140" }
+
+// Model141 对应表 model_141
+type Model141 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model141) TableName() string { return "model_141" }
+
+// Model142 对应表 model_142
+type Model142 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model142) TableName() string { return "model_142" }
+
+// Model143 对应表 model_143
+type Model143 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int    `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int    `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model143) TableName() string { return "model_143" }
+
+// Model144 对应表 model_144
+type Model144 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 float64 `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model144) TableName() string { return "model_144" }
+
+// Model145 对应表 model_145
+type Model145 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model145) TableName() string { return "model_145" }
+
+// Model146 对应表 model_146
+type Model146 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model146) TableName() string { return "model_146" }
+
+// Model147 对应表 model_147
+type Model147 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model147) TableName() string { return "model_147" }
+
+// Model148 对应表 model_148
+type Model148 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model148) TableName() string { return "model_148" }
+
+// Model149 对应表 model_149
+type Model149 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model149) TableName() string { return "model_149" }
+
+// Model150 对应表 model_150
+type Model150 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model150) TableName() string { return "model_150" }
+
+// Model151 对应表 model_151
+type Model151 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model151) TableName() string { return "model_151" }
+
+// Model152 对应表 model_152
+type Model152 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model152) TableName() string { return "model_152" }
+
+// Model153 对应表 model_153
+type Model153 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model153) TableName() string { return "model_153" }
+
+// Model154 对应表 model_154
+type Model154 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model154) TableName() string { return "model_154" }
+
+// Model155 对应表 model_155
+type Model155 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int    `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int    `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model155) TableName() string { return "model_155" }
+
+// Model156 对应表 model_156
+type Model156 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model156) TableName() string { return "model_156" }
+
+// Model157 对应表 model_157
+type Model157 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 float64 `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model157) TableName() string { return "model_157" }
+
+// Model158 对应表 model_158
+type Model158 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model158) TableName() string { return "model_158" }
+
+// Model159 对应表 model_159
+type Model159 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model159) TableName() string { return "model_159" }
+
+// Model160 对应表 model_160
+type Model160 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model160) TableName() string { return "model_160" }
+
+// Model161 对应表 model_161
+type Model161 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model161) TableName() string { return "model_161" }
+
+// Model162 对应表 model_162
+type Model162 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model162) TableName() string { return "model_162" }
+
+// Model163 对应表 model_163
+type Model163 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int    `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model163) TableName() string { return "model_163" }
+
+// Model164 对应表 model_164
+type Model164 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model164) TableName() string { return "model_164" }
+
+// Model165 对应表 model_165
+type Model165 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model165) TableName() string { return "model_165" }
+
+// Model166 对应表 model_166
+type Model166 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model166) TableName() string { return "model_166" }
+
+// Model167 对应表 model_167
+type Model167 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model167) TableName() string { return "model_167" }
+
+// Model168 对应表 model_168
+type Model168 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model168) TableName() string { return "model_168" }
+
+// Model169 对应表 model_169
+type Model169 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int     `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model169) TableName() string { return "model_169" }
+
+// Model170 对应表 model_170
+type Model170 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model170) TableName() string { return "model_170" }
+
+// Model171 对应表 model_171
+type Model171 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model171) TableName() string { return "model_171" }
+
+// Model172 对应表 model_172
+type Model172 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int    `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model172) TableName() string { return "model_172" }
+
+// Model173 对应表 model_173
+type Model173 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model173) TableName() string { return "model_173" }
+
+// Model174 对应表 model_174
+type Model174 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model174) TableName() string { return "model_174" }
+
+// Model175 对应表 model_175
+type Model175 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model175) TableName() string { return "model_175" }
+
+// Model176 对应表 model_176
+type Model176 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model176) TableName() string { return "model_176" }
+
+// Model177 对应表 model_177
+type Model177 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model177) TableName() string { return "model_177" }
+
+// Model178 对应表 model_178
+type Model178 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model178) TableName() string { return "model_178" }
+
+// Model179 对应表 model_179
+type Model179 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model179) TableName() string { return "model_179" }
+
+// Model180 对应表 model_180
+type Model180 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model180) TableName() string { return "model_180" }
+
+// Model181 对应表 model_181
+type Model181 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model181) TableName() string { return "model_181" }
+
+// Model182 对应表 model_182
+type Model182 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model182) TableName() string { return "model_182" }
+
+// Model183 对应表 model_183
+type Model183 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model183) TableName() string { return "model_183" }
+
+// Model184 对应表 model_184
+type Model184 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model184) TableName() string { return "model_184" }
+
+// Model185 对应表 model_185
+type Model185 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model185) TableName() string { return "model_185" }
+
+// Model186 对应表 model_186
+type Model186 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 float64 `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model186) TableName() string { return "model_186" }
+
+// Model187 对应表 model_187
+type Model187 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model187) TableName() string { return "model_187" }
+
+// Model188 对应表 model_188
+type Model188 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int64   `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model188) TableName() string { return "model_188" }
+
+// Model189 对应表 model_189
+type Model189 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model189) TableName() string { return "model_189" }
+
+// Model190 对应表 model_190
+type Model190 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model190) TableName() string { return "model_190" }
+
+// Model191 对应表 model_191
+type Model191 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model191) TableName() string { return "model_191" }
+
+// Model192 对应表 model_192
+type Model192 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model192) TableName() string { return "model_192" }
+
+// Model193 对应表 model_193
+type Model193 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 float64 `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model193) TableName() string { return "model_193" }
+
+// Model194 对应表 model_194
+type Model194 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model194) TableName() string { return "model_194" }
+
+// Model195 对应表 model_195
+type Model195 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model195) TableName() string { return "model_195" }
+
+// Model196 对应表 model_196
+type Model196 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 float64 `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model196) TableName() string { return "model_196" }
+
+// Model197 对应表 model_197
+type Model197 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model197) TableName() string { return "model_197" }
+
+// Model198 对应表 model_198
+type Model198 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model198) TableName() string { return "model_198" }
+
+// Model199 对应表 model_199
+type Model199 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model199) TableName() string { return "model_199" }
+
+// Model200 对应表 model_200
+type Model200 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model200) TableName() string { return "model_200" }
+
+// Model201 对应表 model_201
+type Model201 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int     `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model201) TableName() string { return "model_201" }
+
+// Model202 对应表 model_202
+type Model202 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model202) TableName() string { return "model_202" }
+
+// Model203 对应表 model_203
+type Model203 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model203) TableName() string { return "model_203" }
+
+// Model204 对应表 model_204
+type Model204 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model204) TableName() string { return "model_204" }
+
+// Model205 对应表 model_205
+type Model205 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model205) TableName() string { return "model_205" }
+
+// Model206 对应表 model_206
+type Model206 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model206) TableName() string { return "model_206" }
+
+// Model207 对应表 model_207
+type Model207 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 string  `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model207) TableName() string { return "model_207" }
+
+// Model208 对应表 model_208
+type Model208 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model208) TableName() string { return "model_208" }
+
+// Model209 对应表 model_209
+type Model209 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model209) TableName() string { return "model_209" }
+
+// Model210 对应表 model_210
+type Model210 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model210) TableName() string { return "model_210" }
+
+// Model211 对应表 model_211
+type Model211 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model211) TableName() string { return "model_211" }
+
+// Model212 对应表 model_212
+type Model212 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model212) TableName() string { return "model_212" }
+
+// Model213 对应表 model_213
+type Model213 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model213) TableName() string { return "model_213" }
+
+// Model214 对应表 model_214
+type Model214 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model214) TableName() string { return "model_214" }
+
+// Model215 对应表 model_215
+type Model215 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model215) TableName() string { return "model_215" }
+
+// Model216 对应表 model_216
+type Model216 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model216) TableName() string { return "model_216" }
+
+// Model217 对应表 model_217
+type Model217 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model217) TableName() string { return "model_217" }
+
+// Model218 对应表 model_218
+type Model218 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model218) TableName() string { return "model_218" }
+
+// Model219 对应表 model_219
+type Model219 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model219) TableName() string { return "model_219" }
+
+// Model220 对应表 model_220
+type Model220 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model220) TableName() string { return "model_220" }
+
+// Model221 对应表 model_221
+type Model221 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model221) TableName() string { return "model_221" }
+
+// Model222 对应表 model_222
+type Model222 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model222) TableName() string { return "model_222" }
+
+// Model223 对应表 model_223
+type Model223 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model223) TableName() string { return "model_223" }
+
+// Model224 对应表 model_224
+type Model224 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model224) TableName() string { return "model_224" }
+
+// Model225 对应表 model_225
+type Model225 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int    `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int    `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model225) TableName() string { return "model_225" }
+
+// Model226 对应表 model_226
+type Model226 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model226) TableName() string { return "model_226" }
+
+// Model227 对应表 model_227
+type Model227 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model227) TableName() string { return "model_227" }
+
+// Model228 对应表 model_228
+type Model228 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model228) TableName() string { return "model_228" }
+
+// Model229 对应表 model_229
+type Model229 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model229) TableName() string { return "model_229" }
+
+// Model230 对应表 model_230
+type Model230 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model230) TableName() string { return "model_230" }
+
+// Model231 对应表 model_231
+type Model231 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model231) TableName() string { return "model_231" }
+
+// Model232 对应表 model_232
+type Model232 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 string  `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model232) TableName() string { return "model_232" }
+
+// Model233 对应表 model_233
+type Model233 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model233) TableName() string { return "model_233" }
+
+// Model234 对应表 model_234
+type Model234 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model234) TableName() string { return "model_234" }
+
+// Model235 对应表 model_235
+type Model235 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model235) TableName() string { return "model_235" }
+
+// Model236 对应表 model_236
+type Model236 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model236) TableName() string { return "model_236" }
+
+// Model237 对应表 model_237
+type Model237 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model237) TableName() string { return "model_237" }
+
+// Model238 对应表 model_238
+type Model238 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int64   `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model238) TableName() string { return "model_238" }
+
+// Model239 对应表 model_239
+type Model239 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model239) TableName() string { return "model_239" }
+
+// Model240 对应表 model_240
+type Model240 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model240) TableName() string { return "model_240" }
+
+// Model241 对应表 model_241
+type Model241 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model241) TableName() string { return "model_241" }
+
+// Model242 对应表 model_242
+type Model242 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model242) TableName() string { return "model_242" }
+
+// Model243 对应表 model_243
+type Model243 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model243) TableName() string { return "model_243" }
+
+// Model244 对应表 model_244
+type Model244 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model244) TableName() string { return "model_244" }
+
+// Model245 对应表 model_245
+type Model245 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model245) TableName() string { return "model_245" }
+
+// Model246 对应表 model_246
+type Model246 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 string  `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model246) TableName() string { return "model_246" }
+
+// Model247 对应表 model_247
+type Model247 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int    `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int    `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int    `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int    `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model247) TableName() string { return "model_247" }
+
+// Model248 对应表 model_248
+type Model248 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model248) TableName() string { return "model_248" }
+
+// Model249 对应表 model_249
+type Model249 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model249) TableName() string { return "model_249" }
+
+// Model250 对应表 model_250
+type Model250 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model250) TableName() string { return "model_250" }
+
+// Model251 对应表 model_251
+type Model251 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model251) TableName() string { return "model_251" }
+
+// Model252 对应表 model_252
+type Model252 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int    `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model252) TableName() string { return "model_252" }
+
+// Model253 对应表 model_253
+type Model253 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model253) TableName() string { return "model_253" }
+
+// Model254 对应表 model_254
+type Model254 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int    `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model254) TableName() string { return "model_254" }
+
+// Model255 对应表 model_255
+type Model255 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 float64 `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int     `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model255) TableName() string { return "model_255" }
+
+// Model256 对应表 model_256
+type Model256 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model256) TableName() string { return "model_256" }
+
+// Model257 对应表 model_257
+type Model257 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model257) TableName() string { return "model_257" }
+
+// Model258 对应表 model_258
+type Model258 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int    `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model258) TableName() string { return "model_258" }
+
+// Model259 对应表 model_259
+type Model259 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model259) TableName() string { return "model_259" }
+
+// Model260 对应表 model_260
+type Model260 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model260) TableName() string { return "model_260" }
+
+// Model261 对应表 model_261
+type Model261 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 float64 `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 float64 `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model261) TableName() string { return "model_261" }
+
+// Model262 对应表 model_262
+type Model262 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model262) TableName() string { return "model_262" }
+
+// Model263 对应表 model_263
+type Model263 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int     `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model263) TableName() string { return "model_263" }
+
+// Model264 对应表 model_264
+type Model264 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model264) TableName() string { return "model_264" }
+
+// Model265 对应表 model_265
+type Model265 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model265) TableName() string { return "model_265" }
+
+// Model266 对应表 model_266
+type Model266 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model266) TableName() string { return "model_266" }
+
+// Model267 对应表 model_267
+type Model267 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model267) TableName() string { return "model_267" }
+
+// Model268 对应表 model_268
+type Model268 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model268) TableName() string { return "model_268" }
+
+// Model269 对应表 model_269
+type Model269 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model269) TableName() string { return "model_269" }
+
+// Model270 对应表 model_270
+type Model270 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model270) TableName() string { return "model_270" }
+
+// Model271 对应表 model_271
+type Model271 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model271) TableName() string { return "model_271" }
+
+// Model272 对应表 model_272
+type Model272 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model272) TableName() string { return "model_272" }
+
+// Model273 对应表 model_273
+type Model273 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model273) TableName() string { return "model_273" }
+
+// Model274 对应表 model_274
+type Model274 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model274) TableName() string { return "model_274" }
+
+// Model275 对应表 model_275
+type Model275 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int     `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int     `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model275) TableName() string { return "model_275" }
+
+// Model276 对应表 model_276
+type Model276 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model276) TableName() string { return "model_276" }
+
+// Model277 对应表 model_277
+type Model277 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model277) TableName() string { return "model_277" }
+
+// Model278 对应表 model_278
+type Model278 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model278) TableName() string { return "model_278" }
+
+// Model279 对应表 model_279
+type Model279 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model279) TableName() string { return "model_279" }
+
+// Model280 对应表 model_280
+type Model280 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model280) TableName() string { return "model_280" }
+
+// Model281 对应表 model_281
+type Model281 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 float64 `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 string  `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 float64 `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model281) TableName() string { return "model_281" }
+
+// Model282 对应表 model_282
+type Model282 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 float64 `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model282) TableName() string { return "model_282" }
+
+// Model283 对应表 model_283
+type Model283 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model283) TableName() string { return "model_283" }
+
+// Model284 对应表 model_284
+type Model284 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model284) TableName() string { return "model_284" }
+
+// Model285 对应表 model_285
+type Model285 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model285) TableName() string { return "model_285" }
+
+// Model286 对应表 model_286
+type Model286 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string  `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 string  `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 string  `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 float64 `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model286) TableName() string { return "model_286" }
+
+// Model287 对应表 model_287
+type Model287 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int64   `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int64   `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int64   `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model287) TableName() string { return "model_287" }
+
+// Model288 对应表 model_288
+type Model288 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 string  `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model288) TableName() string { return "model_288" }
+
+// Model289 对应表 model_289
+type Model289 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int     `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 int64   `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 float64 `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model289) TableName() string { return "model_289" }
+
+// Model290 对应表 model_290
+type Model290 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int64   `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int64   `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model290) TableName() string { return "model_290" }
+
+// Model291 对应表 model_291
+type Model291 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 float64 `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int     `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 float64 `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model291) TableName() string { return "model_291" }
+
+// Model292 对应表 model_292
+type Model292 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 float64 `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 string  `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model292) TableName() string { return "model_292" }
+
+// Model293 对应表 model_293
+type Model293 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int64   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model293) TableName() string { return "model_293" }
+
+// Model294 对应表 model_294
+type Model294 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 string  `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int64   `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 float64 `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 int     `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model294) TableName() string { return "model_294" }
+
+// Model295 对应表 model_295
+type Model295 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 string  `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 string  `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model295) TableName() string { return "model_295" }
+
+// Model296 对应表 model_296
+type Model296 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 float64 `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 float64 `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 string  `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 int     `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 int     `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model296) TableName() string { return "model_296" }
+
+// Model297 对应表 model_297
+type Model297 struct {
+	ID      int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 string `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int    `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model297) TableName() string { return "model_297" }
+
+// Model298 对应表 model_298
+type Model298 struct {
+	ID      int64 `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int   `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model298) TableName() string { return "model_298" }
+
+// Model299 对应表 model_299
+type Model299 struct {
+	ID      int64   `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Field01 int     `jorm:"column:field_01" gorm:"column:field_01" xorm:"'field_01'"`
+	Field02 int64   `jorm:"column:field_02" gorm:"column:field_02" xorm:"'field_02'"`
+	Field03 int     `jorm:"column:field_03" gorm:"column:field_03" xorm:"'field_03'"`
+	Field04 int     `jorm:"column:field_04" gorm:"column:field_04" xorm:"'field_04'"`
+	Field05 float64 `jorm:"column:field_05" gorm:"column:field_05" xorm:"'field_05'"`
+	Field06 float64 `jorm:"column:field_06" gorm:"column:field_06" xorm:"'field_06'"`
+	Field07 string  `jorm:"column:field_07" gorm:"column:field_07" xorm:"'field_07'"`
+	Field08 int     `jorm:"column:field_08" gorm:"column:field_08" xorm:"'field_08'"`
+	Field09 string  `jorm:"column:field_09" gorm:"column:field_09" xorm:"'field_09'"`
+	Field10 int64   `jorm:"column:field_10" gorm:"column:field_10" xorm:"'field_10'"`
+	Field11 int64   `jorm:"column:field_11" gorm:"column:field_11" xorm:"'field_11'"`
+	Field12 int     `jorm:"column:field_12" gorm:"column:field_12" xorm:"'field_12'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (Model299) TableName() string { return "model_299" }
+
+// Models 按编号返回每个模型的新实例
+var Models = []func() any{
+	func() any { return new(Model000) },
+	func() any { return new(Model001) },
+	func() any { return new(Model002) },
+	func() any { return new(Model003) },
+	func() any { return new(Model004) },
+	func() any { return new(Model005) },
+	func() any { return new(Model006) },
+	func() any { return new(Model007) },
+	func() any { return new(Model008) },
+	func() any { return new(Model009) },
+	func() any { return new(Model010) },
+	func() any { return new(Model011) },
+	func() any { return new(Model012) },
+	func() any { return new(Model013) },
+	func() any { return new(Model014) },
+	func() any { return new(Model015) },
+	func() any { return new(Model016) },
+	func() any { return new(Model017) },
+	func() any { return new(Model018) },
+	func() any { return new(Model019) },
+	func() any { return new(Model020) },
+	func() any { return new(Model021) },
+	func() any { return new(Model022) },
+	func() any { return new(Model023) },
+	func() any { return new(Model024) },
+	func() any { return new(Model025) },
+	func() any { return new(Model026) },
+	func() any { return new(Model027) },
+	func() any { return new(Model028) },
+	func() any { return new(Model029) },
+	func() any { return new(Model030) },
+	func() any { return new(Model031) },
+	func() any { return new(Model032) },
+	func() any { return new(Model033) },
+	func() any { return new(Model034) },
+	func() any { return new(Model035) },
+	func() any { return new(Model036) },
+	func() any { return new(Model037) },
+	func() any { return new(Model038) },
+	func() any { return new(Model039) },
+	func() any { return new(Model040) },
+	func() any { return new(Model041) },
+	func() any { return new(Model042) },
+	func() any { return new(Model043) },
+	func() any { return new(Model044) },
+	func() any { return new(Model045) },
+	func() any { return new(Model046) },
+	func() any { return new(Model047) },
+	func() any { return new(Model048) },
+	func() any { return new(Model049) },
+	func() any { return new(Model050) },
+	func() any { return new(Model051) },
+	func() any { return new(Model052) },
+	func() any { return new(Model053) },
+	func() any { return new(Model054) },
+	func() any { return new(Model055) },
+	func() any { return new(Model056) },
+	func() any { return new(Model057) },
+	func() any { return new(Model058) },
+	func() any { return new(Model059) },
+	func() any { return new(Model060) },
+	func() any { return new(Model061) },
+	func() any { return new(Model062) },
+	func() any { return new(Model063) },
+	func() any { return new(Model064) },
+	func() any { return new(Model065) },
+	func() any { return new(Model066) },
+	func() any { return new(Model067) },
+	func() any { return new(Model068) },
+	func() any { return new(Model069) },
+	func() any { return new(Model070) },
+	func() any { return new(Model071) },
+	func() any { return new(Model072) },
+	func() any { return new(Model073) },
+	func() any { return new(Model074) },
+	func() any { return new(Model075) },
+	func() any { return new(Model076) },
+	func() any { return new(Model077) },
+	func() any { return new(Model078) },
+	func() any { return new(Model079) },
+	func() any { return new(Model080) },
+	func() any { return new(Model081) },
+	func() any { return new(Model082) },
+	func() any { return new(Model083) },
+	func() any { return new(Model084) },
+	func() any { return new(Model085) },
+	func() any { return new(Model086) },
+	func() any { return new(Model087) },
+	func() any { return new(Model088) },
+	func() any { return new(Model089) },
+	func() any { return new(Model090) },
+	func() any { return new(Model091) },
+	func() any { return new(Model092) },
+	func() any { return new(Model093) },
+	func() any { return new(Model094) },
+	func() any { return new(Model095) },
+	func() any { return new(Model096) },
+	func() any { return new(Model097) },
+	func() any { return new(Model098) },
+	func() any { return new(Model099) },
+	func() any { return new(Model100) },
+	func() any { return new(Model101) },
+	func() any { return new(Model102) },
+	func() any { return new(Model103) },
+	func() any { return new(Model104) },
+	func() any { return new(Model105) },
+	func() any { return new(Model106) },
+	func() any { return new(Model107) },
+	func() any { return new(Model108) },
+	func() any { return new(Model109) },
+	func() any { return new(Model110) },
+	func() any { return new(Model111) },
+	func() any { return new(Model112) },
+	func() any { return new(Model113) },
+	func() any { return new(Model114) },
+	func() any { return new(Model115) },
+	func() any { return new(Model116) },
+	func() any { return new(Model117) },
+	func() any { return new(Model118) },
+	func() any { return new(Model119) },
+	func() any { return new(Model120) },
+	func() any { return new(Model121) },
+	func() any { return new(Model122) },
+	func() any { return new(Model123) },
+	func() any { return new(Model124) },
+	func() any { return new(Model125) },
+	func() any { return new(Model126) },
+	func() any { return new(Model127) },
+	func() any { return new(Model128) },
+	func() any { return new(Model129) },
+	func() any { return new(Model130) },
+	func() any { return new(Model131) },
+	func() any { return new(Model132) },
+	func() any { return new(Model133) },
+	func() any { return new(Model134) },
+	func() any { return new(Model135) },
+	func() any { return new(Model136) },
+	func() any { return new(Model137) },
+	func() any { return new(Model138) },
+	func() any { return new(Model139) },
+	func() any { return new(Model140) },
+	func() any { return new(Model141) },
+	func() any { return new(Model142) },
+	func() any { return new(Model143) },
+	func() any { return new(Model144) },
+	func() any { return new(Model145) },
+	func() any { return new(Model146) },
+	func() any { return new(Model147) },
+	func() any { return new(Model148) },
+	func() any { return new(Model149) },
+	func() any { return new(Model150) },
+	func() any { return new(Model151) },
+	func() any { return new(Model152) },
+	func() any { return new(Model153) },
+	func() any { return new(Model154) },
+	func() any { return new(Model155) },
+	func() any { return new(Model156) },
+	func() any { return new(Model157) },
+	func() any { return new(Model158) },
+	func() any { return new(Model159) },
+	func() any { return new(Model160) },
+	func() any { return new(Model161) },
+	func() any { return new(Model162) },
+	func() any { return new(Model163) },
+	func() any { return new(Model164) },
+	func() any { return new(Model165) },
+	func() any { return new(Model166) },
+	func() any { return new(Model167) },
+	func() any { return new(Model168) },
+	func() any { return new(Model169) },
+	func() any { return new(Model170) },
+	func() any { return new(Model171) },
+	func() any { return new(Model172) },
+	func() any { return new(Model173) },
+	func() any { return new(Model174) },
+	func() any { return new(Model175) },
+	func() any { return new(Model176) },
+	func() any { return new(Model177) },
+	func() any { return new(Model178) },
+	func() any { return new(Model179) },
+	func() any { return new(Model180) },
+	func() any { return new(Model181) },
+	func() any { return new(Model182) },
+	func() any { return new(Model183) },
+	func() any { return new(Model184) },
+	func() any { return new(Model185) },
+	func() any { return new(Model186) },
+	func() any { return new(Model187) },
+	func() any { return new(Model188) },
+	func() any { return new(Model189) },
+	func() any { return new(Model190) },
+	func() any { return new(Model191) },
+	func() any { return new(Model192) },
+	func() any { return new(Model193) },
+	func() any { return new(Model194) },
+	func() any { return new(Model195) },
+	func() any { return new(Model196) },
+	func() any { return new(Model197) },
+	func() any { return new(Model198) },
+	func() any { return new(Model199) },
+	func() any { return new(Model200) },
+	func() any { return new(Model201) },
+	func() any { return new(Model202) },
+	func() any { return new(Model203) },
+	func() any { return new(Model204) },
+	func() any { return new(Model205) },
+	func() any { return new(Model206) },
+	func() any { return new(Model207) },
+	func() any { return new(Model208) },
+	func() any { return new(Model209) },
+	func() any { return new(Model210) },
+	func() any { return new(Model211) },
+	func() any { return new(Model212) },
+	func() any { return new(Model213) },
+	func() any { return new(Model214) },
+	func() any { return new(Model215) },
+	func() any { return new(Model216) },
+	func() any { return new(Model217) },
+	func() any { return new(Model218) },
+	func() any { return new(Model219) },
+	func() any { return new(Model220) },
+	func() any { return new(Model221) },
+	func() any { return new(Model222) },
+	func() any { return new(Model223) },
+	func() any { return new(Model224) },
+	func() any { return new(Model225) },
+	func() any { return new(Model226) },
+	func() any { return new(Model227) },
+	func() any { return new(Model228) },
+	func() any { return new(Model229) },
+	func() any { return new(Model230) },
+	func() any { return new(Model231) },
+	func() any { return new(Model232) },
+	func() any { return new(Model233) },
+	func() any { return new(Model234) },
+	func() any { return new(Model235) },
+	func() any { return new(Model236) },
+	func() any { return new(Model237) },
+	func() any { return new(Model238) },
+	func() any { return new(Model239) },
+	func() any { return new(Model240) },
+	func() any { return new(Model241) },
+	func() any { return new(Model242) },
+	func() any { return new(Model243) },
+	func() any { return new(Model244) },
+	func() any { return new(Model245) },
+	func() any { return new(Model246) },
+	func() any { return new(Model247) },
+	func() any { return new(Model248) },
+	func() any { return new(Model249) },
+	func() any { return new(Model250) },
+	func() any { return new(Model251) },
+	func() any { return new(Model252) },
+	func() any { return new(Model253) },
+	func() any { return new(Model254) },
+	func() any { return new(Model255) },
+	func() any { return new(Model256) },
+	func() any { return new(Model257) },
+	func() any { return new(Model258) },
+	func() any { return new(Model259) },
+	func() any { return new(Model260) },
+	func() any { return new(Model261) },
+	func() any { return new(Model262) },
+	func() any { return new(Model263) },
+	func() any { return new(Model264) },
+	func() any { return new(Model265) },
+	func() any { return new(Model266) },
+	func() any { return new(Model267) },
+	func() any { return new(Model268) },
+	func() any { return new(Model269) },
+	func() any { return new(Model270) },
+	func() any { return new(Model271) },
+	func() any { return new(Model272) },
+	func() any { return new(Model273) },
+	func() any { return new(Model274) },
+	func() any { return new(Model275) },
+	func() any { return new(Model276) },
+	func() any { return new(Model277) },
+	func() any { return new(Model278) },
+	func() any { return new(Model279) },
+	func() any { return new(Model280) },
+	func() any { return new(Model281) },
+	func() any { return new(Model282) },
+	func() any { return new(Model283) },
+	func() any { return new(Model284) },
+	func() any { return new(Model285) },
+	func() any { return new(Model286) },
+	func() any { return new(Model287) },
+	func() any { return new(Model288) },
+	func() any { return new(Model289) },
+	func() any { return new(Model290) },
+	func() any { return new(Model291) },
+	func() any { return new(Model292) },
+	func() any { return new(Model293) },
+	func() any { return new(Model294) },
+	func() any { return new(Model295) },
+	func() any { return new(Model296) },
+	func() any { return new(Model297) },
+	func() any { return new(Model298) },
+	func() any { return new(Model299) },
+}
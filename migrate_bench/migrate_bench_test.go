@@ -0,0 +1,138 @@
+package migrate_bench
+
+import (
+	"testing"
+
+	xormlog "xorm.io/xorm/log"
+
+	"goapi/report"
+	"goapi/sqlcount/sqlcounttest"
+)
+
+// migrateRows 是迁移 benchmark 中 users 已有的行数
+const migrateRows = 100000
+
+// restore 把 users 恢复为 create_table.sql 的结构与 migrateRows 行数据，每次迭代前在计时之外调用，
+// 让每次迁移都面对同一张还没迁移过的表。
+// 只有 SQLite 预置数据模板会整库替换，MySQL 或 Fixtures 为 off 时 Seed 只清空数据，
+// 上一次迁移加的列和索引还在，所以先删除 users (连同它的索引) 再重建
+func restore(b *testing.B) {
+	b.Helper()
+	b.StopTimer()
+	t, err := benchTarget()
+	if err != nil {
+		b.Fatalf("load config: %v", err)
+	}
+	db, err := NewSQLDB()
+	if err != nil {
+		b.Fatalf("open db: %v", err)
+	}
+	_, err = db.Exec("DROP TABLE IF EXISTS users")
+	db.Close()
+	if err != nil {
+		b.Fatalf("drop users: %v", err)
+	}
+	if err := t.Seed(migrateRows); err != nil {
+		b.Fatalf("seed users: %v", err)
+	}
+	b.StartTimer()
+}
+
+// migrator 在计时之前打开 orm，返回执行一次迁移的函数：jorm AutoMigrate、gorm AutoMigrate、xorm Sync2
+func migrator(b *testing.B, orm string) func(model any) error {
+	b.Helper()
+	switch orm {
+	case report.ORMJorm:
+		engine, err := NewJormEngine()
+		if err != nil {
+			b.Fatalf("new jorm engine: %v", err)
+		}
+		b.Cleanup(func() { engine.Close() })
+		return func(model any) error { return engine.AutoMigrate(model) }
+	case report.ORMGorm:
+		db, err := NewGormDB()
+		if err != nil {
+			b.Fatalf("new gorm db: %v", err)
+		}
+		b.Cleanup(func() {
+			if sqlDB, err := db.DB(); err == nil {
+				sqlDB.Close()
+			}
+		})
+		return func(model any) error { return db.AutoMigrate(model) }
+	case report.ORMXorm:
+		engine, err := NewXormEngine()
+		if err != nil {
+			b.Fatalf("new xorm engine: %v", err)
+		}
+		// 模型与已有表的可空性不一致时 Sync2 会输出警告，这里只保留错误日志
+		engine.SetLogLevel(xormlog.LOG_ERR)
+		b.Cleanup(func() { engine.Close() })
+		return func(model any) error { return engine.Sync2(model) }
+	}
+	b.Fatalf("unknown orm %q", orm)
+	return nil
+}
+
+// benchMigrate 每次迭代先恢复 users，再只对迁移计时
+func benchMigrate(b *testing.B, orm string, model any) {
+	restore(b)
+	migrate := migrator(b, orm)
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		if i > 0 {
+			restore(b)
+		}
+		if err := migrate(model); err != nil {
+			b.Fatalf("%s migrate: %v", orm, err)
+		}
+	}
+}
+
+// BenchmarkJormMigrateExisting 测试模型与已有的 users 一致时 jorm AutoMigrate 的耗时 (只读取表结构)
+func BenchmarkJormMigrateExisting(b *testing.B) {
+	benchMigrate(b, report.ORMJorm, &User{})
+}
+
+// BenchmarkGormMigrateExisting 测试 gorm AutoMigrate 已有的 users。模型没有声明 NOT NULL，
+// gorm 会重建整张表去掉约束 (见 testdata/parity.golden)，耗时随行数增长
+func BenchmarkGormMigrateExisting(b *testing.B) {
+	benchMigrate(b, report.ORMGorm, &User{})
+}
+
+// BenchmarkXormMigrateExisting 测试 xorm Sync2 已有的 users
+func BenchmarkXormMigrateExisting(b *testing.B) {
+	benchMigrate(b, report.ORMXorm, &User{})
+}
+
+// BenchmarkJormMigrateAddColumn 测试 jorm AutoMigrate 为已有数据的 users 新增 email 列
+func BenchmarkJormMigrateAddColumn(b *testing.B) {
+	benchMigrate(b, report.ORMJorm, &UserWithEmail{})
+}
+
+// BenchmarkGormMigrateAddColumn 测试 gorm AutoMigrate 为已有数据的 users 新增 email 列
+func BenchmarkGormMigrateAddColumn(b *testing.B) {
+	benchMigrate(b, report.ORMGorm, &UserWithEmail{})
+}
+
+// BenchmarkXormMigrateAddColumn 测试 xorm Sync2 为已有数据的 users 新增 email 列
+func BenchmarkXormMigrateAddColumn(b *testing.B) {
+	benchMigrate(b, report.ORMXorm, &UserWithEmail{})
+}
+
+// BenchmarkJormMigrateAddIndex 测试 jorm AutoMigrate 在已有数据的 users.username 上建唯一索引
+func BenchmarkJormMigrateAddIndex(b *testing.B) {
+	benchMigrate(b, report.ORMJorm, &UserUniqueName{})
+}
+
+// BenchmarkGormMigrateAddIndex 测试 gorm AutoMigrate 在已有数据的 users.username 上建唯一索引
+func BenchmarkGormMigrateAddIndex(b *testing.B) {
+	benchMigrate(b, report.ORMGorm, &UserUniqueName{})
+}
+
+// BenchmarkXormMigrateAddIndex 测试 xorm Sync2 在已有数据的 users.username 上建唯一索引
+func BenchmarkXormMigrateAddIndex(b *testing.B) {
+	benchMigrate(b, report.ORMXorm, &UserUniqueName{})
+}
@@ -0,0 +1,76 @@
+// Package migrate_bench 对比 jorm AutoMigrate、gorm AutoMigrate 与 xorm Sync2 生成的表结构，
+// 并测试它们在已有大量数据的表上执行迁移的耗时
+package migrate_bench
+
+import "time"
+
+// User 与其他 benchmark 包相同，迁移 benchmark 在预置了 migrateRows 行的 users 表上执行
+// 注意：struct tag 同时包含 jorm / gorm / xorm 的配置
+
+type User struct {
+	ID   int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Name string `jorm:"column:username" gorm:"column:username" xorm:"'username'"`
+	Age  int    `jorm:"column:age" gorm:"column:age" xorm:"'age'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (User) TableName() string {
+	return "users"
+}
+
+// UserWithEmail 在 users 上新增一列，测试对已有数据的表加列
+type UserWithEmail struct {
+	ID    int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Name  string `jorm:"column:username" gorm:"column:username" xorm:"'username'"`
+	Age   int    `jorm:"column:age" gorm:"column:age" xorm:"'age'"`
+	Email string `jorm:"column:email" gorm:"column:email" xorm:"'email'"`
+}
+
+// TableName 与 User 相同
+func (UserWithEmail) TableName() string {
+	return "users"
+}
+
+// UserUniqueName 为 users.username 增加唯一索引，测试在已有数据上建索引
+type UserUniqueName struct {
+	ID   int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Name string `jorm:"column:username;unique" gorm:"column:username;uniqueIndex" xorm:"'username' unique"`
+	Age  int    `jorm:"column:age" gorm:"column:age" xorm:"'age'"`
+}
+
+// TableName 与 User 相同
+func (UserUniqueName) TableName() string {
+	return "users"
+}
+
+// Account 覆盖常见的列属性：非空、长度、默认值、唯一、布尔、时间与二进制
+type Account struct {
+	ID        int64     `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Email     string    `jorm:"column:email;size:191;notnull;unique" gorm:"column:email;size:191;not null;uniqueIndex" xorm:"'email' varchar(191) notnull unique"`
+	Nickname  string    `jorm:"column:nickname;default:'guest'" gorm:"column:nickname;default:guest" xorm:"'nickname' default('guest')"`
+	Balance   float64   `jorm:"column:balance;notnull;default:0" gorm:"column:balance;not null;default:0" xorm:"'balance' notnull default(0)"`
+	Active    bool      `jorm:"column:active" gorm:"column:active" xorm:"'active'"`
+	Avatar    []byte    `jorm:"column:avatar" gorm:"column:avatar" xorm:"'avatar'"`
+	CreatedAt time.Time `jorm:"column:created_at" gorm:"column:created_at" xorm:"'created_at'"`
+}
+
+// TableName 返回 accounts
+func (Account) TableName() string {
+	return "accounts"
+}
+
+// Order 带一个普通索引 (jorm 的 tag 没有普通索引)
+type Order struct {
+	ID        int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	AccountID int64  `jorm:"column:account_id;notnull" gorm:"column:account_id;not null;index" xorm:"'account_id' notnull index"`
+	Amount    int64  `jorm:"column:amount;notnull" gorm:"column:amount;not null" xorm:"'amount' notnull"`
+	Status    string `jorm:"column:status;size:16" gorm:"column:status;size:16" xorm:"'status' varchar(16)"`
+}
+
+// TableName 返回 orders
+func (Order) TableName() string {
+	return "orders"
+}
+
+// Models 是对比表结构的模型
+var Models = []any{&User{}, &Account{}, &Order{}}
@@ -0,0 +1,174 @@
+package migrate_bench
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shrek82/jorm/model"
+	xormlog "xorm.io/xorm/log"
+
+	"goapi/ddl"
+	"goapi/report"
+	"goapi/scenario"
+)
+
+var update = flag.Bool("update", false, "重新生成 testdata/parity.golden")
+
+// migrate 用 orm 自己的迁移方法处理 models：jorm AutoMigrate、gorm AutoMigrate、xorm Sync2
+func migrate(orm string, t scenario.Target, models ...any) error {
+	switch orm {
+	case report.ORMJorm:
+		db, err := scenario.OpenJorm(t)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		return db.AutoMigrate(models...)
+	case report.ORMGorm:
+		db, err := scenario.OpenGorm(t)
+		if err != nil {
+			return err
+		}
+		if sqlDB, err := db.DB(); err == nil {
+			defer sqlDB.Close()
+		}
+		return db.AutoMigrate(models...)
+	case report.ORMXorm:
+		engine, err := scenario.OpenXorm(t)
+		if err != nil {
+			return err
+		}
+		defer engine.Close()
+		engine.SetLogLevel(xormlog.LOG_ERR)
+		return engine.Sync2(models...)
+	}
+	return fmt.Errorf("unknown orm %q", orm)
+}
+
+// TestSchemaParity 把 Models 分别迁移到三个空的 SQLite 数据库，读取实际的表结构两两比较。
+// 差异写在 testdata/parity.golden 中，ORM 升级或模型变化导致差异改变时失败，确认后用 -update 重新生成
+func TestSchemaParity(t *testing.T) {
+	dir := t.TempDir()
+	tables := map[string]map[string]ddl.Table{}
+	for _, orm := range scenario.ORMs {
+		target := scenario.Target{Driver: "sqlite3", DSN: filepath.Join(dir, orm+".db")}
+		if err := migrate(orm, target, Models...); err != nil {
+			t.Fatalf("%s migrate: %v", orm, err)
+		}
+		db, err := sql.Open(target.Driver, target.DSN)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tables[orm] = map[string]ddl.Table{}
+		for _, m := range Models {
+			name := tableName(t, m)
+			tbl, err := ddl.Inspect(db, name)
+			if err != nil {
+				t.Fatalf("%s: %v", orm, err)
+			}
+			tables[orm][name] = tbl
+		}
+		db.Close()
+	}
+
+	var b strings.Builder
+	for _, m := range Models {
+		name := tableName(t, m)
+		fmt.Fprintf(&b, "## %s\n\n", name)
+		for _, orm := range scenario.ORMs {
+			fmt.Fprintf(&b, "%s: %s\n", orm, tables[orm][name].SQL)
+		}
+		b.WriteString("\n")
+		var diffs []string
+		orms := scenario.ORMs
+		for i, x := range orms {
+			for _, y := range orms[i+1:] {
+				diffs = append(diffs, ddl.Diff(x, y, tables[x][name], tables[y][name])...)
+			}
+		}
+		if len(diffs) == 0 {
+			diffs = []string{"no differences"}
+		}
+		for _, d := range diffs {
+			fmt.Fprintf(&b, "- %s\n", d)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(existingUsers(t, dir))
+	got := b.String()
+
+	golden := filepath.Join("testdata", "parity.golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v (run go test -run TestSchemaParity -update ./migrate_bench)", err)
+	}
+	if got != string(want) {
+		t.Errorf("schema differences changed; review and rerun with -update:\n%s", got)
+	}
+}
+
+// existingUsers 在按 create_table.sql 建好并有数据的 users 上迁移 User (与 benchmark 相同的情形)，
+// 报告每个 ORM 对已有表做了哪些改动
+func existingUsers(t *testing.T, dir string) string {
+	var b strings.Builder
+	b.WriteString("## users (existing, created by create_table.sql)\n\n")
+	for _, orm := range scenario.ORMs {
+		target := scenario.Target{Driver: "sqlite3", DSN: filepath.Join(dir, orm+"-existing.db"), Fixtures: scenario.FixturesOff}
+		if err := target.Seed(10); err != nil {
+			t.Fatal(err)
+		}
+		db, err := sql.Open(target.Driver, target.DSN)
+		if err != nil {
+			t.Fatal(err)
+		}
+		before, err := ddl.Inspect(db, "users")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := migrate(orm, target, &User{}); err != nil {
+			t.Fatalf("%s migrate existing users: %v", orm, err)
+		}
+		after, err := ddl.Inspect(db, "users")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var n int
+		if err := db.QueryRow("SELECT COUNT(*) FROM users").Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		db.Close()
+		diffs := ddl.Diff("before", orm, before, after)
+		if n != 10 {
+			diffs = append(diffs, fmt.Sprintf("rows: before=10 %s=%d", orm, n))
+		}
+		if len(diffs) == 0 {
+			fmt.Fprintf(&b, "- %s: unchanged\n", orm)
+		}
+		for _, d := range diffs {
+			fmt.Fprintf(&b, "- %s\n", d)
+		}
+	}
+	return b.String()
+}
+
+func tableName(t *testing.T, v any) string {
+	m, err := model.GetModel(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m.TableName
+}
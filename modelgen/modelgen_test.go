@@ -0,0 +1,55 @@
+package modelgen
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	opts := Options{Package: "models", Count: 12, Seed: 1}
+	var a, b bytes.Buffer
+	if err := Generate(&a, opts); err != nil {
+		t.Fatal(err)
+	}
+	if err := Generate(&b, opts); err != nil {
+		t.Fatal(err)
+	}
+	if a.String() != b.String() {
+		t.Error("same options generated different code")
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "models_gen.go", a.Bytes(), 0)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v", err)
+	}
+	if f.Name.Name != "models" {
+		t.Errorf("package = %s", f.Name.Name)
+	}
+	for _, want := range []string{"type Model000 struct", "type Model011 struct", `return "model_011"`, "func() any { return new(Model011) }"} {
+		if !strings.Contains(a.String(), want) {
+			t.Errorf("missing %q", want)
+		}
+	}
+}
+
+func TestModels(t *testing.T) {
+	models := Models(Options{Count: 300, Seed: 1})
+	seen := map[string]bool{}
+	for _, m := range models {
+		if seen[m.Table] {
+			t.Fatalf("duplicate table %s", m.Table)
+		}
+		seen[m.Table] = true
+		if len(m.Fields) < 2 || len(m.Fields) > maxFields {
+			t.Errorf("%s has %d fields", m.Name, len(m.Fields))
+		}
+	}
+	if models[299].Name != "Model299" {
+		t.Errorf("last model = %s", models[299].Name)
+	}
+	if err := Generate(&bytes.Buffer{}, Options{Package: "x"}); err == nil {
+		t.Error("expected error for zero count")
+	}
+}
@@ -0,0 +1,194 @@
+// Package profdiff 读取 go test 生成的 CPU / 内存 profile，按每次操作归一化后，
+// 对比两个 ORM 在同一场景下的热点函数与内存分配位置
+package profdiff
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/pprof/profile"
+)
+
+// 常用的 sample 类型
+const (
+	CPU          = "cpu"           // CPU profile，单位 ns
+	AllocSpace   = "alloc_space"   // 内存 profile 中累计分配的字节数
+	AllocObjects = "alloc_objects" // 内存 profile 中累计分配的对象数
+)
+
+// DefaultIgnore 匹配 benchmark 中计时区间之外的准备、预热与单独测量的函数，这些栈上的样本不计入结果
+var DefaultIgnore = regexp.MustCompile(`setupTestData|truncateUsers|setupModels|warmUp|measureFirstModels`)
+
+// Entry 是一个函数 (或分配位置) 在每次操作中的开销
+type Entry struct {
+	Name string
+	Flat float64
+	Cum  float64
+}
+
+// Options 控制如何从 profile 中提取条目
+type Options struct {
+	// SampleType 是要读取的 sample 类型，例如 CPU 或 AllocSpace
+	SampleType string
+	// Focus 非空时只统计调用栈中包含该函数名片段的样本，通常是 benchmark 函数名
+	Focus string
+	// Ignore 调用栈中任一帧匹配时丢弃该样本
+	Ignore *regexp.Regexp
+	// PerOp 是 benchmark 实测的每次操作开销 (ns/op、B/op 或 allocs/op)。
+	// profile 覆盖的总操作次数未知 (b.N 会多轮递增)，因此只取 profile 中的分布，
+	// 再按比例缩放到 PerOp，使各条目 flat 之和等于实测值
+	PerOp float64
+	// Sites 为 true 时按 "函数 文件:行号" 聚合，用于定位分配位置；否则按函数聚合
+	Sites bool
+}
+
+// Load 读取 profile 文件，返回按名称索引的条目
+func Load(path string, opts Options) (map[string]*Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	p, err := profile.Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return Extract(p, opts)
+}
+
+// Extract 从已解析的 profile 中按 opts 提取条目
+func Extract(p *profile.Profile, opts Options) (map[string]*Entry, error) {
+	idx := -1
+	for i, st := range p.SampleType {
+		if st.Type == opts.SampleType || (opts.SampleType == CPU && st.Unit == "nanoseconds") {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, fmt.Errorf("profile has no %q samples", opts.SampleType)
+	}
+	entries := make(map[string]*Entry)
+	var total float64
+	for _, s := range p.Sample {
+		frames := frameNames(s, opts.Sites)
+		if len(frames) == 0 || !keep(frames, opts) {
+			continue
+		}
+		v := float64(s.Value[idx])
+		total += v
+		seen := make(map[string]bool, len(frames))
+		for i, name := range frames {
+			e, ok := entries[name]
+			if !ok {
+				e = &Entry{Name: name}
+				entries[name] = e
+			}
+			if i == 0 {
+				e.Flat += v
+			}
+			// 递归调用时同一函数在栈上出现多次，cum 只计一次
+			if !seen[name] {
+				e.Cum += v
+				seen[name] = true
+			}
+		}
+	}
+	if opts.PerOp > 0 && total > 0 {
+		scale := opts.PerOp / total
+		for _, e := range entries {
+			e.Flat *= scale
+			e.Cum *= scale
+		}
+	}
+	return entries, nil
+}
+
+// frameNames 返回从叶子到根的帧名称，内联帧展开为独立的帧
+func frameNames(s *profile.Sample, sites bool) []string {
+	var names []string
+	for _, loc := range s.Location {
+		for _, line := range loc.Line {
+			if line.Function == nil {
+				continue
+			}
+			name := line.Function.Name
+			if sites {
+				name = fmt.Sprintf("%s %s:%d", name, shortFile(line.Function.Filename), line.Line)
+			}
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func keep(frames []string, opts Options) bool {
+	focused := opts.Focus == ""
+	for _, name := range frames {
+		if opts.Ignore != nil && opts.Ignore.MatchString(name) {
+			return false
+		}
+		if !focused && strings.Contains(name, opts.Focus) {
+			focused = true
+		}
+	}
+	return focused
+}
+
+// shortFile 只保留最后两级路径，例如 jorm@v1.0.0-alpha.6/core/query.go -> core/query.go
+func shortFile(path string) string {
+	parts := strings.Split(path, "/")
+	if len(parts) > 2 {
+		parts = parts[len(parts)-2:]
+	}
+	return strings.Join(parts, "/")
+}
+
+// Row 是差异报告中的一行，A 为被比较方 (通常是 jorm)，B 为对照方
+type Row struct {
+	Name string
+	A, B float64
+}
+
+// Diff 返回 flat 开销差值 A-B 最大的 n 个条目，即 A 比 B 多花费最多的函数或分配位置
+func Diff(a, b map[string]*Entry, n int) []Row {
+	names := make(map[string]bool, len(a)+len(b))
+	for name := range a {
+		names[name] = true
+	}
+	for name := range b {
+		names[name] = true
+	}
+	rows := make([]Row, 0, len(names))
+	for name := range names {
+		var r Row
+		r.Name = name
+		if e := a[name]; e != nil {
+			r.A = e.Flat
+		}
+		if e := b[name]; e != nil {
+			r.B = e.Flat
+		}
+		if r.A == 0 && r.B == 0 {
+			continue
+		}
+		rows = append(rows, r)
+	}
+	slices.SortFunc(rows, func(x, y Row) int {
+		dx, dy := x.A-x.B, y.A-y.B
+		switch {
+		case dx > dy:
+			return -1
+		case dx < dy:
+			return 1
+		}
+		return strings.Compare(x.Name, y.Name)
+	})
+	if n > 0 && len(rows) > n {
+		rows = rows[:n]
+	}
+	return rows
+}
@@ -0,0 +1,91 @@
+package profdiff
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/pprof/profile"
+)
+
+// fakeProfile 构造一个 CPU profile，每个 sample 由从叶子到根的函数名组成
+func fakeProfile(samples map[int64][]string) *profile.Profile {
+	p := &profile.Profile{SampleType: []*profile.ValueType{{Type: "samples", Unit: "count"}, {Type: "cpu", Unit: "nanoseconds"}}}
+	funcs := make(map[string]*profile.Function)
+	var id uint64
+	for v, stack := range samples {
+		s := &profile.Sample{Value: []int64{1, v}}
+		for _, name := range stack {
+			fn, ok := funcs[name]
+			if !ok {
+				id++
+				fn = &profile.Function{ID: id, Name: name, Filename: "/src/pkg/" + name + ".go"}
+				funcs[name] = fn
+				p.Function = append(p.Function, fn)
+			}
+			loc := &profile.Location{ID: uint64(len(p.Location) + 1), Line: []profile.Line{{Function: fn, Line: 10}}}
+			p.Location = append(p.Location, loc)
+			s.Location = append(s.Location, loc)
+		}
+		p.Sample = append(p.Sample, s)
+	}
+	return p
+}
+
+func TestExtractAndDiff(t *testing.T) {
+	jorm := fakeProfile(map[int64][]string{
+		60: {"reflect.Value.Set", "jorm.scan", "bench.BenchmarkJormFindAll"},
+		40: {"sqlite3.step", "bench.BenchmarkJormFindAll"},
+		// 准备数据的样本不计入
+		500: {"sqlite3.step", "bench.setupTestData", "bench.BenchmarkJormFindAll"},
+		// 不在 benchmark 调用栈上的样本 (例如 GC 后台任务) 不计入
+		70: {"runtime.gcBgMarkWorker"},
+	})
+	gorm := fakeProfile(map[int64][]string{
+		20: {"reflect.Value.Set", "gorm.scan", "bench.BenchmarkGormFindAll"},
+		80: {"sqlite3.step", "bench.BenchmarkGormFindAll"},
+	})
+	opts := Options{SampleType: CPU, Ignore: regexp.MustCompile("setupTestData")}
+
+	opts.Focus, opts.PerOp = ".BenchmarkJormFindAll", 1000
+	a, err := Extract(jorm, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := a["reflect.Value.Set"].Flat; got != 600 {
+		t.Errorf("flat scaled to per-op = %v, want 600", got)
+	}
+	if got := a["jorm.scan"].Cum; got != 600 {
+		t.Errorf("cum = %v, want 600", got)
+	}
+	if _, ok := a["runtime.gcBgMarkWorker"]; ok {
+		t.Error("unfocused sample was kept")
+	}
+
+	opts.Focus, opts.PerOp = ".BenchmarkGormFindAll", 500
+	b, err := Extract(gorm, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := Diff(a, b, 1)
+	if len(rows) != 1 || rows[0].Name != "reflect.Value.Set" || rows[0].A != 600 || rows[0].B != 100 {
+		t.Errorf("top diff = %+v", rows)
+	}
+}
+
+func TestDefaultIgnore(t *testing.T) {
+	for _, fn := range []string{
+		"goapi/find_bench.setupTestData",
+		"goapi/create_bench.truncateUsers",
+		"goapi/manymodels_bench.setupModels",
+		"goapi/logger_bench.warmUp",
+		"goapi/coldstart_bench.measureFirstModels",
+	} {
+		if !DefaultIgnore.MatchString(fn) {
+			t.Errorf("DefaultIgnore does not match %s", fn)
+		}
+	}
+	if DefaultIgnore.MatchString("goapi/logger_bench.benchmarkLog") {
+		t.Error("DefaultIgnore matches the timed benchmarkLog loop")
+	}
+}
@@ -0,0 +1,147 @@
+package profdiff
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"goapi/report"
+)
+
+// ManifestFile 是结果目录中记录全部 profile 的清单文件名
+const ManifestFile = "profiles.json"
+
+// Capture 是一个场景 / ORM 组合的 profile 记录，路径相对于结果目录
+type Capture struct {
+	Package   string        `json:"package"`
+	Benchmark string        `json:"benchmark"`
+	Result    report.Result `json:"result"`
+	CPU       string        `json:"cpu"`
+	Mem       string        `json:"mem"`
+}
+
+// SaveManifest 把清单写入 dir/profiles.json
+func SaveManifest(dir string, captures []Capture) error {
+	data, err := json.MarshalIndent(captures, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, ManifestFile), append(data, '\n'), 0o644)
+}
+
+// LoadManifest 读取 dir/profiles.json
+func LoadManifest(dir string) ([]Capture, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	var captures []Capture
+	if err := json.Unmarshal(data, &captures); err != nil {
+		return nil, fmt.Errorf("%s: %w", ManifestFile, err)
+	}
+	return captures, nil
+}
+
+// WriteReport 对每个场景，把 jorm 的 profile 与其余 ORM 中 ns/op 最低的一个对比，
+// 输出 CPU 热点函数、分配字节与分配次数三张差异 Top-N 表。
+// 数值是按 profile 分布折算到实测 ns/op、B/op、allocs/op 的每次操作开销
+func WriteReport(w io.Writer, dir string, captures []Capture, topN int) error {
+	var results []report.Result
+	byKey := make(map[string]Capture)
+	for _, c := range captures {
+		results = append(results, c.Result)
+		byKey[c.Result.Scenario+"/"+c.Result.ORM] = c
+	}
+	t := report.Group(results)
+
+	bw := bufio.NewWriter(w)
+	for _, s := range t.Scenarios {
+		jorm, ok := byKey[s+"/"+report.ORMJorm]
+		if !ok {
+			continue
+		}
+		other, ok := bestOther(t, s)
+		if !ok {
+			continue
+		}
+		peer := byKey[s+"/"+other]
+		verdict := "领先"
+		if jorm.Result.NsPerOp > peer.Result.NsPerOp {
+			verdict = "落后"
+		}
+		fmt.Fprintf(bw, "## %s：JORM vs %s\n\n", report.ScenarioLabel(s), strings.ToUpper(other))
+		fmt.Fprintf(bw, "JORM %s ns/op，%s %s ns/op，JORM %s。\n\n",
+			report.FormatInt(jorm.Result.NsPerOp), strings.ToUpper(other), report.FormatInt(peer.Result.NsPerOp), verdict)
+
+		sections := []struct {
+			title, sampleType, unit string
+			sites                   bool
+		}{
+			{"CPU 热点函数", CPU, report.MetricNs, false},
+			{"分配字节 (按分配位置)", AllocSpace, report.MetricBytes, true},
+			{"分配次数 (按分配位置)", AllocObjects, report.MetricAllocs, true},
+		}
+		for _, sec := range sections {
+			a, err := loadCapture(dir, jorm, sec.sampleType, sec.unit, sec.sites)
+			if err != nil {
+				return err
+			}
+			b, err := loadCapture(dir, peer, sec.sampleType, sec.unit, sec.sites)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(bw, "### %s (%s，按 JORM 多出的开销排序)\n\n", sec.title, sec.unit)
+			fmt.Fprintf(bw, "| 函数 | JORM | %s | 差值 |\n", strings.ToUpper(other))
+			fmt.Fprintln(bw, "|-----|-----|-----|-----|")
+			for _, r := range Diff(a, b, topN) {
+				fmt.Fprintf(bw, "| `%s` | %s | %s | %s |\n", r.Name, formatValue(r.A), formatValue(r.B), formatValue(r.A-r.B))
+			}
+			fmt.Fprintln(bw)
+		}
+	}
+	return bw.Flush()
+}
+
+// bestOther 返回场景中除 jorm 外 ns/op 最低的 ORM
+func bestOther(t *report.Table, scenario string) (string, bool) {
+	best, bestNs := "", 0.0
+	for _, orm := range t.ORMs {
+		if orm == report.ORMJorm {
+			continue
+		}
+		c := t.Cell(scenario, orm)
+		if c == nil {
+			continue
+		}
+		if best == "" || c.NsPerOp < bestNs {
+			best, bestNs = orm, c.NsPerOp
+		}
+	}
+	return best, best != ""
+}
+
+// loadCapture 读取 capture 中的 profile，并缩放到 benchmark 实测的 metric 值
+func loadCapture(dir string, c Capture, sampleType, metric string, sites bool) (map[string]*Entry, error) {
+	path := c.Mem
+	if sampleType == CPU {
+		path = c.CPU
+	}
+	return Load(filepath.Join(dir, path), Options{
+		SampleType: sampleType,
+		Focus:      "." + c.Benchmark,
+		Ignore:     DefaultIgnore,
+		PerOp:      c.Result.Metric(metric),
+		Sites:      sites,
+	})
+}
+
+func formatValue(v float64) string {
+	if v > -10 && v < 10 {
+		return fmt.Sprintf("%.2f", v)
+	}
+	return report.FormatInt(v)
+}
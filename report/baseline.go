@@ -0,0 +1,137 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+
+	"goapi/envinfo"
+
+	// 链接 jorm，使 debug.ReadBuildInfo 的依赖列表中包含 jorm 的实际版本
+	_ "github.com/shrek82/jorm"
+)
+
+// JormModule 是 jorm 的模块路径，用于从构建信息中读取版本
+const JormModule = "github.com/shrek82/jorm"
+
+// Baseline 是保存到磁盘的一组 benchmark 结果，作为升级 jorm 前后对比的基线
+type Baseline struct {
+	// JormVersion 来自结果头部的 jorm 行 (产生结果的测试二进制)，没有头部时来自本进程的 runtime/debug.ReadBuildInfo，
+	// 本地 replace 时为 "(devel)" 或替换目录
+	JormVersion string            `json:"jorm_version"`
+	GoVersion   string            `json:"go_version"`
+	CreatedAt   time.Time         `json:"created_at"`
+	Config      map[string]string `json:"config,omitempty"`
+	// Results 保留每次运行的原始样本，比较时才能做显著性检验
+	Results []Result `json:"results"`
+}
+
+// NewBaseline 为 set 打上 jorm 版本标签：优先使用结果头部记录的版本，
+// 头部缺失时才使用当前进程的构建信息
+func NewBaseline(set *Set) *Baseline {
+	version := set.Config["jorm"]
+	if version == "" {
+		version = envinfo.ModuleVersion(JormModule)
+	}
+	return &Baseline{
+		JormVersion: version,
+		GoVersion:   runtime.Version(),
+		CreatedAt:   time.Now(),
+		Config:      set.Config,
+		Results:     set.Results,
+	}
+}
+
+// Save 以缩进 JSON 写入 path
+func (b *Baseline) Save(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Load 读取结果文件，既支持 Save 写出的基线 JSON，
+// 也支持 go test -bench 的文本输出和 go test -json 输出 (此时不带版本信息)
+func Load(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var b Baseline
+	if err := json.Unmarshal(data, &b); err == nil && b.Results != nil {
+		return &b, nil
+	}
+	set, err := Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &Baseline{Config: set.Config, Results: set.Results}, nil
+}
+
+// Thresholds 是各指标允许的最大相对增长，例如 0.05 表示变慢 5% 以内不算回归
+type Thresholds struct {
+	Ns     float64
+	Bytes  float64
+	Allocs float64
+}
+
+// DefaultThresholds ns/op 与 B/op 容忍 5% 波动，allocs/op 是确定值，不容忍增长
+var DefaultThresholds = Thresholds{Ns: 0.05, Bytes: 0.05, Allocs: 0}
+
+func (th Thresholds) of(metric string) float64 {
+	switch metric {
+	case MetricBytes:
+		return th.Bytes
+	case MetricAllocs:
+		return th.Allocs
+	}
+	return th.Ns
+}
+
+// Finding 是基线与当前结果在某个场景、ORM、指标上的比较
+type Finding struct {
+	Scenario string
+	ORM      string
+	Metric   string
+	Base     Summary
+	Cur      Summary
+	Delta    Delta
+	// Regression 差异显著且超过阈值，Suspect 超过阈值但样本不足以做检验
+	Regression bool
+	Suspect    bool
+}
+
+// Diff 对 base 和 cur 中都存在的每个场景、ORM 比较 ns/op、B/op、allocs/op，
+// 三个指标都是越小越好，增长超过阈值且 Mann-Whitney U 检验显著时记为回归
+func Diff(base, cur *Baseline, th Thresholds, alpha float64) []Finding {
+	bt, ct := Group(base.Results), Group(cur.Results)
+	var out []Finding
+	for _, s := range ct.Scenarios {
+		for _, orm := range ct.ORMs {
+			bc, cc := bt.Cell(s, orm), ct.Cell(s, orm)
+			if bc == nil || cc == nil {
+				continue
+			}
+			for _, metric := range []string{MetricNs, MetricBytes, MetricAllocs} {
+				bs, cs := bc.Samples(metric), cc.Samples(metric)
+				f := Finding{
+					Scenario: s,
+					ORM:      orm,
+					Metric:   metric,
+					Base:     Summarize(bs),
+					Cur:      Summarize(cs),
+					Delta:    Compare(bs, cs, alpha),
+				}
+				over := f.Delta.Ratio > th.of(metric)
+				f.Regression = over && f.Delta.Significant
+				f.Suspect = over && !f.Delta.Tested
+				out = append(out, f)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+package report
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func samples(orm, scenario string, ns, allocs []float64) []Result {
+	var out []Result
+	for i := range ns {
+		out = append(out, Result{
+			Name:        orm + scenario,
+			ORM:         orm,
+			Scenario:    scenario,
+			N:           1000,
+			NsPerOp:     ns[i],
+			BytesPerOp:  1884,
+			AllocsPerOp: allocs[i],
+		})
+	}
+	return out
+}
+
+func TestDiff(t *testing.T) {
+	base := &Baseline{JormVersion: "v1.0.0-alpha.6", Results: samples(ORMJorm, "Insert",
+		[]float64{1000, 1010, 990, 1005, 995}, []float64{36, 36, 36, 36, 36})}
+	cur := &Baseline{JormVersion: "v1.0.0-alpha.7", Results: samples(ORMJorm, "Insert",
+		[]float64{1200, 1210, 1190, 1205, 1195}, []float64{36, 36, 36, 36, 36})}
+
+	findings := Diff(base, cur, DefaultThresholds, DefaultAlpha)
+	if len(findings) != 3 {
+		t.Fatalf("got %d findings, want 3", len(findings))
+	}
+	for _, f := range findings {
+		want := f.Metric == MetricNs
+		if f.Regression != want {
+			t.Errorf("%s: regression = %v, want %v (%+v)", f.Metric, f.Regression, want, f.Delta)
+		}
+	}
+
+	// 超过阈值但只有一次运行：无法检验，只标记为疑似
+	single := Diff(
+		&Baseline{Results: samples(ORMJorm, "Insert", []float64{1000}, []float64{36})},
+		&Baseline{Results: samples(ORMJorm, "Insert", []float64{1500}, []float64{40})},
+		DefaultThresholds, DefaultAlpha)
+	for _, f := range single {
+		if f.Regression {
+			t.Errorf("%s: untested delta must not gate", f.Metric)
+		}
+		if f.Metric != MetricBytes && !f.Suspect {
+			t.Errorf("%s: expected suspect", f.Metric)
+		}
+	}
+}
+
+func TestBaselineRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	b := NewBaseline(&Set{Results: samples(ORMGorm, "FindAll", []float64{3e6}, []float64{7790})})
+	if b.JormVersion == "" || b.JormVersion == "unknown" {
+		t.Fatalf("jorm version not read from build info: %q", b.JormVersion)
+	}
+	if err := b.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.JormVersion != b.JormVersion || len(got.Results) != 1 || got.Results[0].Scenario != "FindAll" {
+		t.Errorf("round trip mismatch: %+v", got)
+	}
+}
+
+func TestNewBaselineHeaderVersion(t *testing.T) {
+	b := NewBaseline(&Set{Config: map[string]string{"jorm": "v1.0.0-alpha.5"}})
+	if b.JormVersion != "v1.0.0-alpha.5" {
+		t.Errorf("JormVersion = %q, want the header version", b.JormVersion)
+	}
+}
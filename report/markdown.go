@@ -0,0 +1,294 @@
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"goapi/envinfo"
+)
+
+// README 中性能表格的起止标记，WriteReadme 只替换两个标记之间的内容
+const (
+	MarkerBegin = "<!-- bench:begin -->"
+	MarkerEnd   = "<!-- bench:end -->"
+)
+
+// README 中测试环境列表的起止标记，存在时 WriteReadme 用结果中记录的环境替换
+const (
+	EnvMarkerBegin = "<!-- env:begin -->"
+	EnvMarkerEnd   = "<!-- env:end -->"
+)
+
+// WriteMarkdown 输出与 jorm_readme.md "性能对比" 一节相同格式的表格，
+// 表格后附上 jorm 相对其他 ORM 的 QPS 差异。
+// 有多次运行 (-count) 时 ns/op 附带 95% 置信区间，差异不显著的对比标记为 "~"，并追加统计明细表
+func WriteMarkdown(w io.Writer, t *Table) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "| 操作类型 | ORM 框架 | QPS (每秒查询次数) | 执行时间 (ns/op) | 内存分配 (B/op) | 分配次数 (allocs/op) | JORM QPS 对比 |")
+	fmt.Fprintln(bw, "|---------|---------|------------------|----------------|---------------|-------------------|--------------|")
+	for _, s := range t.Scenarios {
+		first := true
+		for _, orm := range t.ORMs {
+			c := t.Cell(s, orm)
+			if c == nil {
+				continue
+			}
+			label := "| |"
+			if first {
+				label = "| **" + ScenarioLabel(s) + "** |"
+				first = false
+			}
+			rel := "-"
+			if orm != ORMJorm {
+				if d, ok := t.Relative(s, orm); ok {
+					rel = d.String()
+				}
+			}
+			ns := FormatInt(c.NsPerOp)
+			if c.Ns.N > 1 {
+				ns += fmt.Sprintf(" ±%.0f%%", c.Ns.RelCI()*100)
+			}
+			fmt.Fprintf(bw, "%s %s | %s | %s | %s | %s | %s |\n",
+				label, ormLabel(orm),
+				FormatInt(c.QPS()), ns, FormatInt(c.BytesPerOp), FormatInt(c.AllocsPerOp),
+				rel)
+		}
+	}
+
+	var notes []string
+	for _, s := range t.Scenarios {
+		if line := summaryLine(t, s); line != "" {
+			notes = append(notes, line)
+		}
+	}
+	if len(notes) > 0 {
+		fmt.Fprintln(bw)
+		for _, line := range notes {
+			fmt.Fprintln(bw, "- "+line)
+		}
+	}
+	if t.HasMetric(roundTripMetrics[0]) {
+		fmt.Fprintln(bw)
+		writeRoundTrips(bw, t)
+	}
+	if t.MultiRun() {
+		fmt.Fprintln(bw)
+		writeStats(bw, t)
+		writeNoise(bw, t)
+	}
+	if envinfo.Has(t.Env) {
+		fmt.Fprintln(bw)
+		fmt.Fprintln(bw, "测试环境：")
+		fmt.Fprintln(bw)
+		envinfo.WriteMarkdown(bw, t.Env)
+	}
+	return bw.Flush()
+}
+
+// roundTripMetrics 是 sqlcount 计数驱动上报的指标
+var roundTripMetrics = []string{"queries/op", "prepares/op", "tx/op", "sql-B/op"}
+
+// writeRoundTrips 输出驱动层统计的每次操作往返次数
+func writeRoundTrips(w io.Writer, t *Table) {
+	fmt.Fprintln(w, "驱动层往返统计：")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| 操作类型 | ORM 框架 | "+strings.Join(roundTripMetrics, " | ")+" |")
+	fmt.Fprintln(w, "|---------|---------|"+strings.Repeat("-----|", len(roundTripMetrics)))
+	for _, s := range t.Scenarios {
+		for _, orm := range t.ORMs {
+			c := t.Cell(s, orm)
+			if c == nil {
+				continue
+			}
+			row := []string{ScenarioLabel(s), ormLabel(orm)}
+			for _, m := range roundTripMetrics {
+				row = append(row, strconv.FormatFloat(Summarize(c.Samples(m)).Mean, 'f', -1, 64))
+			}
+			fmt.Fprintln(w, "| "+strings.Join(row, " | ")+" |")
+		}
+	}
+}
+
+// writeStats 输出每组 ns/op 样本的描述统计
+func writeStats(w io.Writer, t *Table) {
+	fmt.Fprintf(w, "ns/op 统计明细 (95%% 置信区间，Mann-Whitney U 检验 α=%.2f)：\n\n", t.Alpha)
+	fmt.Fprintln(w, "| 操作类型 | ORM 框架 | 样本数 | 均值 | 中位数 | 标准差 | 95% 置信区间 |")
+	fmt.Fprintln(w, "|---------|---------|-------|-----|-------|-------|-------------|")
+	for _, s := range t.Scenarios {
+		for _, orm := range t.ORMs {
+			c := t.Cell(s, orm)
+			if c == nil {
+				continue
+			}
+			fmt.Fprintf(w, "| %s | %s | %d | %s | %s | %s | [%s, %s] |\n",
+				ScenarioLabel(s), ormLabel(orm), c.Ns.N,
+				FormatInt(c.Ns.Mean), FormatInt(c.Ns.Median), FormatInt(c.Ns.Stddev),
+				FormatInt(c.Ns.CILow), FormatInt(c.Ns.CIHigh))
+		}
+	}
+}
+
+// writeNoise 列出不稳定或差异小于噪声的分组，只运行一次的分组不在这里提示
+func writeNoise(w io.Writer, t *Table) {
+	var lines []string
+	for _, warn := range CheckNoise(t, t.MaxCV) {
+		if warn.Scenario != "" {
+			lines = append(lines, warn.String())
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "测量噪声警告：")
+	fmt.Fprintln(w)
+	for _, line := range lines {
+		fmt.Fprintln(w, "- "+line)
+	}
+}
+
+// summaryLine 生成 "按 ID 查询：JORM 的 QPS 比 GORM 高约 15.6%，与 XORM 无显著差异" 这样的描述
+func summaryLine(t *Table, scenario string) string {
+	var parts []string
+	for _, orm := range t.ORMs {
+		if orm == ORMJorm {
+			continue
+		}
+		d, ok := t.Relative(scenario, orm)
+		if !ok {
+			continue
+		}
+		if d.Insignificant() {
+			parts = append(parts, fmt.Sprintf("与 %s 无显著差异", ormLabel(orm)))
+			continue
+		}
+		word := "高"
+		if d.Ratio < 0 {
+			word = "低"
+		}
+		parts = append(parts, fmt.Sprintf("比 %s %s约 %.1f%%", ormLabel(orm), word, math.Abs(d.Ratio)*100))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s：JORM 的 QPS %s", ScenarioLabel(scenario), strings.Join(parts, "，"))
+}
+
+// WriteReadme 把渲染好的表格写入 path 中 MarkerBegin 与 MarkerEnd 之间，其余内容保持不变。
+// 文件中有 EnvMarkerBegin / EnvMarkerEnd 时，测试环境写在这两个标记之间而不是表格后面
+func WriteReadme(path string, t *Table) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	tbl := *t
+	if bytes.Contains(data, []byte(EnvMarkerBegin)) && envinfo.Has(t.Env) {
+		var env bytes.Buffer
+		envinfo.WriteMarkdown(&env, t.Env)
+		if data, err = replaceBetween(data, EnvMarkerBegin, EnvMarkerEnd, env.Bytes()); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		tbl.Env = nil
+	}
+
+	var buf bytes.Buffer
+	if err := WriteMarkdown(&buf, &tbl); err != nil {
+		return err
+	}
+	if data, err = replaceBetween(data, MarkerBegin, MarkerEnd, buf.Bytes()); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// replaceBetween 把 begin 与 end 标记之间的内容替换为 content，标记本身保留
+func replaceBetween(data []byte, begin, end string, content []byte) ([]byte, error) {
+	i := bytes.Index(data, []byte(begin))
+	j := bytes.Index(data, []byte(end))
+	if i < 0 || j < i {
+		return nil, fmt.Errorf("missing %s / %s markers", begin, end)
+	}
+	var buf bytes.Buffer
+	buf.Write(data[:i+len(begin)])
+	buf.WriteString("\n")
+	buf.Write(content)
+	buf.Write(data[j:])
+	return buf.Bytes(), nil
+}
+
+// FormatInt 四舍五入后按千分位输出，例如 469885.4 -> 469,885
+func FormatInt(v float64) string {
+	s := strconv.FormatInt(int64(math.Round(v)), 10)
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	var b strings.Builder
+	if neg {
+		b.WriteByte('-')
+	}
+	for i, c := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// FormatPercent 输出带符号的百分比，例如 0.156 -> +15.6%
+func FormatPercent(r float64) string {
+	return fmt.Sprintf("%+.1f%%", r*100)
+}
+
+// WriteDiff 输出基线与当前结果的对比表，只列出发生回归、疑似回归或显著改善的条目
+func WriteDiff(w io.Writer, base, cur *Baseline, findings []Finding) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "基线 jorm %s (%s) → 当前 jorm %s (%s)\n\n",
+		orUnknown(base.JormVersion), base.CreatedAt.Format("2006-01-02"),
+		orUnknown(cur.JormVersion), cur.CreatedAt.Format("2006-01-02"))
+	// 环境不同 (换了机器、Go 或 SQLite 版本) 时结果不完全可比，先列出来
+	if diffs := envinfo.Diff(base.Config, cur.Config); len(diffs) > 0 {
+		fmt.Fprintln(bw, "环境差异：")
+		fmt.Fprintln(bw)
+		for _, d := range diffs {
+			fmt.Fprintln(bw, "- "+d)
+		}
+		fmt.Fprintln(bw)
+	}
+	fmt.Fprintln(bw, "| 操作类型 | ORM 框架 | 指标 | 基线 | 当前 | 变化 | 结论 |")
+	fmt.Fprintln(bw, "|---------|---------|-----|-----|-----|-----|-----|")
+	rows := 0
+	for _, f := range findings {
+		verdict := ""
+		switch {
+		case f.Regression:
+			verdict = "**回归**"
+		case f.Suspect:
+			verdict = "疑似回归 (样本不足，未检验)"
+		case f.Delta.Significant && f.Delta.Ratio < 0:
+			verdict = "改善"
+		default:
+			continue
+		}
+		rows++
+		fmt.Fprintf(bw, "| %s | %s | %s | %s | %s | %s | %s |\n",
+			ScenarioLabel(f.Scenario), ormLabel(f.ORM), f.Metric,
+			FormatInt(f.Base.Mean), FormatInt(f.Cur.Mean), f.Delta, verdict)
+	}
+	if rows == 0 {
+		fmt.Fprintln(bw, "| - | - | - | - | - | - | 无显著变化 |")
+	}
+	return bw.Flush()
+}
+
+func orUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
@@ -0,0 +1,80 @@
+package report
+
+import (
+	"fmt"
+	"math"
+)
+
+// DefaultMaxCV 是 ns/op 变异系数 (标准差 / 均值) 的默认上限，超过时认为结果不稳定
+const DefaultMaxCV = 0.05
+
+// CV 返回变异系数，样本不足 2 个时为 0
+func (s Summary) CV() float64 {
+	if s.N < 2 || s.Mean == 0 {
+		return 0
+	}
+	return s.Stddev / s.Mean
+}
+
+// Warning 是一条测量噪声警告
+type Warning struct {
+	Scenario string
+	// ORM 为空表示针对整个场景
+	ORM     string
+	Message string
+}
+
+func (w Warning) String() string {
+	switch {
+	case w.Scenario == "":
+		return w.Message
+	case w.ORM == "":
+		return ScenarioLabel(w.Scenario) + ": " + w.Message
+	}
+	return fmt.Sprintf("%s %s: %s", ScenarioLabel(w.Scenario), ormLabel(w.ORM), w.Message)
+}
+
+// CheckNoise 检查每组 ns/op 样本的变异系数，超过 maxCV 的记为不稳定；
+// 再把 jorm 与其他 ORM 的 ns/op 差异和两侧的噪声比较，差异小于噪声时结论不可信。
+// 只运行一次的分组无法估计噪声，整体给出一条提示
+func CheckNoise(t *Table, maxCV float64) []Warning {
+	if maxCV <= 0 {
+		maxCV = DefaultMaxCV
+	}
+	var out []Warning
+	single := false
+	for _, s := range t.Scenarios {
+		for _, orm := range t.ORMs {
+			c := t.Cell(s, orm)
+			if c == nil {
+				continue
+			}
+			if c.Ns.N < 2 {
+				single = true
+				continue
+			}
+			if cv := c.Ns.CV(); cv > maxCV {
+				out = append(out, Warning{s, orm, fmt.Sprintf("结果不稳定，ns/op 变异系数 %.1f%% 超过 %.1f%%", cv*100, maxCV*100)})
+			}
+		}
+		base := t.Cell(s, ORMJorm)
+		if base == nil || base.Ns.N < 2 || base.NsPerOp == 0 {
+			continue
+		}
+		for _, orm := range t.ORMs {
+			c := t.Cell(s, orm)
+			if orm == ORMJorm || c == nil || c.Ns.N < 2 {
+				continue
+			}
+			diff := math.Abs(c.NsPerOp/base.NsPerOp - 1)
+			noise := max(base.Ns.CV(), c.Ns.CV())
+			if diff < noise {
+				out = append(out, Warning{s, orm, fmt.Sprintf("与 JORM 的差异 %.1f%% 小于测量噪声 %.1f%%", diff*100, noise*100)})
+			}
+		}
+	}
+	if single {
+		out = append(out, Warning{Message: "部分结果只运行了一次，无法估计噪声，请使用 -count 多次运行"})
+	}
+	return out
+}
@@ -0,0 +1,41 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCheckNoise(t *testing.T) {
+	var lines []string
+	for i := 0; i < 5; i++ {
+		lines = append(lines,
+			// jorm 与 gorm 稳定且差异明显；xorm 波动很大，与 jorm 的差异小于噪声
+			fmt.Sprintf("BenchmarkJormFindByID 1000 %d ns/op", 27000+i*100),
+			fmt.Sprintf("BenchmarkGormFindByID 1000 %d ns/op", 31000+i*100),
+			fmt.Sprintf("BenchmarkXormFindByID 1000 %d ns/op", 20000+i*4000),
+		)
+	}
+	lines = append(lines, "BenchmarkJormInsert 1000 400000 ns/op")
+	set, err := Parse(strings.NewReader(strings.Join(lines, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, w := range CheckNoise(Group(set.Results), 0.05) {
+		got = append(got, w.String())
+	}
+	want := []string{
+		"按 ID 查询 XORM: 结果不稳定",
+		"按 ID 查询 XORM: 与 JORM 的差异",
+		"部分结果只运行了一次",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("warnings = %q", got)
+	}
+	for i := range want {
+		if !strings.HasPrefix(got[i], want[i]) {
+			t.Errorf("warning %d = %q, want prefix %q", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,365 @@
+package report
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ORM 名称，与 benchmark 函数名前缀 (BenchmarkJormXxx / BenchmarkGormXxx ...) 对应
+const (
+	ORMJorm = "jorm"
+	ORMGorm = "gorm"
+	ORMXorm = "xorm"
+	ORMRaw  = "raw"
+)
+
+// KnownORMs 报告中 ORM 的默认展示顺序，jorm 永远排在第一位作为对比基准
+var KnownORMs = []string{ORMJorm, ORMGorm, ORMXorm, ORMRaw}
+
+// Result 是一行 benchmark 输出解析后的结果
+type Result struct {
+	// Name 去掉 Benchmark 前缀和 -GOMAXPROCS 后缀后的完整名称，例如 JormFindByID
+	Name     string `json:"name"`
+	Package  string `json:"package,omitempty"`
+	ORM      string `json:"orm"`
+	Scenario string `json:"scenario"`
+	Procs    int    `json:"procs,omitempty"`
+	N        int    `json:"n"`
+
+	NsPerOp     float64 `json:"ns_per_op"`
+	BytesPerOp  float64 `json:"bytes_per_op"`
+	AllocsPerOp float64 `json:"allocs_per_op"`
+	// Metrics 保存 b.ReportMetric 上报的其他指标，例如 queries/op
+	Metrics map[string]float64 `json:"metrics,omitempty"`
+}
+
+// 内置指标单位
+const (
+	MetricNs     = "ns/op"
+	MetricBytes  = "B/op"
+	MetricAllocs = "allocs/op"
+)
+
+// Metric 按单位返回指标值，未上报的自定义指标返回 0
+func (r Result) Metric(unit string) float64 {
+	switch unit {
+	case MetricNs:
+		return r.NsPerOp
+	case MetricBytes:
+		return r.BytesPerOp
+	case MetricAllocs:
+		return r.AllocsPerOp
+	}
+	return r.Metrics[unit]
+}
+
+// QPS 根据 ns/op 换算每秒操作次数
+func (r Result) QPS() float64 {
+	if r.NsPerOp <= 0 {
+		return 0
+	}
+	return 1e9 / r.NsPerOp
+}
+
+// Set 是一次或多次 go test -bench 运行的全部结果
+type Set struct {
+	// Config 保存输出头部的 goos / goarch / cpu 等键值
+	Config  map[string]string `json:"config,omitempty"`
+	Results []Result          `json:"results"`
+}
+
+// testEvent 是 go test -json (test2json) 输出的单条事件
+type testEvent struct {
+	Action  string
+	Package string
+	Output  string
+}
+
+// Parse 解析 go test -bench 的文本输出或 go test -json 的 test2json 输出，
+// 两种格式根据第一行非空内容自动识别
+func Parse(r io.Reader) (*Set, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(1)
+	for err == nil && (head[0] == ' ' || head[0] == '\t' || head[0] == '\r' || head[0] == '\n') {
+		br.ReadByte()
+		head, err = br.Peek(1)
+	}
+	if err == io.EOF {
+		return &Set{Config: map[string]string{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if head[0] == '{' {
+		return parseJSON(br)
+	}
+	p := newParser()
+	sc := newScanner(br)
+	for sc.Scan() {
+		p.line(sc.Text(), "")
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return p.set, nil
+}
+
+// parseJSON 按包拼接 output 事件后再逐行解析，
+// test2json 可能把一行 benchmark 结果拆成多个 output 事件
+func parseJSON(r io.Reader) (*Set, error) {
+	p := newParser()
+	pending := make(map[string]*bytes.Buffer)
+	var order []string
+
+	flush := func(pkg string, all bool) {
+		buf := pending[pkg]
+		for {
+			data := buf.Bytes()
+			i := bytes.IndexByte(data, '\n')
+			if i < 0 {
+				break
+			}
+			p.line(string(data[:i]), pkg)
+			buf.Next(i + 1)
+		}
+		if all && buf.Len() > 0 {
+			p.line(buf.String(), pkg)
+			buf.Reset()
+		}
+	}
+
+	sc := newScanner(r)
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var ev testEvent
+		if err := json.Unmarshal(line, &ev); err != nil {
+			return nil, fmt.Errorf("parse test2json event: %w", err)
+		}
+		if ev.Action != "output" {
+			continue
+		}
+		buf, ok := pending[ev.Package]
+		if !ok {
+			buf = new(bytes.Buffer)
+			pending[ev.Package] = buf
+			order = append(order, ev.Package)
+		}
+		buf.WriteString(ev.Output)
+		flush(ev.Package, false)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	for _, pkg := range order {
+		flush(pkg, true)
+	}
+	return p.set, nil
+}
+
+func newScanner(r io.Reader) *bufio.Scanner {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	return sc
+}
+
+type parser struct {
+	set *Set
+	pkg string
+}
+
+func newParser() *parser {
+	return &parser{set: &Set{Config: map[string]string{}}}
+}
+
+// line 处理一行文本输出，pkg 非空时优先使用 test2json 事件中的包名
+func (p *parser) line(text, pkg string) {
+	text = strings.TrimRight(text, "\r")
+	if k, v, ok := configLine(text); ok {
+		if k == "pkg" {
+			p.pkg = v
+		} else {
+			p.set.Config[k] = v
+		}
+		return
+	}
+	res, ok := ParseLine(text)
+	if !ok {
+		return
+	}
+	res.Package = p.pkg
+	if pkg != "" {
+		res.Package = pkg
+	}
+	p.set.Results = append(p.set.Results, res)
+}
+
+// configLine 识别 "goos: linux" 这类头部键值行
+func configLine(text string) (key, value string, ok bool) {
+	k, v, found := strings.Cut(text, ": ")
+	if !found || k == "" {
+		return "", "", false
+	}
+	for i := 0; i < len(k); i++ {
+		if c := k[i]; !isLower(c) && c != '_' && c != '-' {
+			return "", "", false
+		}
+	}
+	return k, strings.TrimSpace(v), true
+}
+
+// ParseLine 解析单行 benchmark 结果，例如
+//
+//	BenchmarkJormInsert-8   2128   469885 ns/op   1884 B/op   36 allocs/op
+//
+// 被跳过或出错的 benchmark 会输出 N 为 0、指标为 NaN 的行，这些行不计入结果
+func ParseLine(text string) (Result, bool) {
+	fields := strings.Fields(text)
+	if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+		return Result{}, false
+	}
+	n, err := strconv.Atoi(fields[1])
+	if err != nil || n <= 0 {
+		return Result{}, false
+	}
+
+	res := Result{N: n}
+	res.Name, res.Procs = splitProcs(strings.TrimPrefix(fields[0], "Benchmark"))
+	res.ORM, res.Scenario = SplitName(res.Name)
+
+	for i := 2; i+1 < len(fields); i += 2 {
+		v, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return Result{}, false
+		}
+		switch unit := fields[i+1]; unit {
+		case MetricNs:
+			res.NsPerOp = v
+		case MetricBytes:
+			res.BytesPerOp = v
+		case MetricAllocs:
+			res.AllocsPerOp = v
+		default:
+			if res.Metrics == nil {
+				res.Metrics = make(map[string]float64)
+			}
+			res.Metrics[unit] = v
+		}
+	}
+	if res.NsPerOp == 0 {
+		return Result{}, false
+	}
+	return res, true
+}
+
+// FormatLine 按 go test -bench 的格式输出单条结果，procs 为 1 时与 go test 一样省略 -N 后缀，
+// 输出可以再由 ParseLine 解析
+func FormatLine(r Result) string {
+	var sb strings.Builder
+	sb.WriteString("Benchmark" + r.Name)
+	if r.Procs > 1 {
+		fmt.Fprintf(&sb, "-%d", r.Procs)
+	}
+	fmt.Fprintf(&sb, "\t%8d\t%s %s", r.N, prettyFloat(r.NsPerOp), MetricNs)
+	fmt.Fprintf(&sb, "\t%8.0f %s\t%8.0f %s", r.BytesPerOp, MetricBytes, r.AllocsPerOp, MetricAllocs)
+	units := make([]string, 0, len(r.Metrics))
+	for unit := range r.Metrics {
+		units = append(units, unit)
+	}
+	slices.Sort(units)
+	for _, unit := range units {
+		fmt.Fprintf(&sb, "\t%s %s", prettyFloat(r.Metrics[unit]), unit)
+	}
+	return sb.String()
+}
+
+// WriteText 以 go test -bench 的文本格式输出结果集，包含 goos 等头部与 pkg 行
+func WriteText(w io.Writer, set *Set) error {
+	bw := bufio.NewWriter(w)
+	keys := make([]string, 0, len(set.Config))
+	for k := range set.Config {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		fmt.Fprintf(bw, "%s: %s\n", k, set.Config[k])
+	}
+	pkg := ""
+	for _, r := range set.Results {
+		if r.Package != pkg && r.Package != "" {
+			pkg = r.Package
+			fmt.Fprintf(bw, "pkg: %s\n", pkg)
+		}
+		fmt.Fprintln(bw, FormatLine(r))
+	}
+	return bw.Flush()
+}
+
+// prettyFloat 与 testing 包一致：数值越小保留的小数位越多
+func prettyFloat(x float64) string {
+	switch y := math.Abs(x); {
+	case y == 0 || y >= 999.95:
+		return fmt.Sprintf("%10.0f", x)
+	case y >= 99.995:
+		return fmt.Sprintf("%12.1f", x)
+	case y >= 9.9995:
+		return fmt.Sprintf("%13.2f", x)
+	case y >= 0.99995:
+		return fmt.Sprintf("%14.3f", x)
+	case y >= 0.099995:
+		return fmt.Sprintf("%15.4f", x)
+	case y >= 0.0099995:
+		return fmt.Sprintf("%16.5f", x)
+	}
+	return fmt.Sprintf("%17.6f", x)
+}
+
+// splitProcs 去掉名称末尾的 -GOMAXPROCS 后缀
+func splitProcs(name string) (string, int) {
+	i := strings.LastIndexByte(name, '-')
+	if i <= 0 {
+		return name, 0
+	}
+	procs, err := strconv.Atoi(name[i+1:])
+	if err != nil || procs <= 0 {
+		return name, 0
+	}
+	return name[:i], procs
+}
+
+// SplitName 从 benchmark 名称中拆出 ORM 与场景名，支持两种命名方式：
+// 前缀式 JormFindByID -> (jorm, FindByID)，
+// 子测试式 Logger/gorm/level=info -> (gorm, Logger/level=info)。
+// 识别不出 ORM 时返回空 ORM 和原名称
+func SplitName(name string) (orm, scenario string) {
+	parts := strings.Split(name, "/")
+	for _, o := range KnownORMs {
+		prefix := strings.ToUpper(o[:1]) + o[1:]
+		if rest, ok := strings.CutPrefix(parts[0], prefix); ok && rest != "" && !isLower(rest[0]) {
+			parts[0] = rest
+			return o, strings.Join(parts, "/")
+		}
+	}
+	for i, part := range parts {
+		for _, o := range KnownORMs {
+			if strings.EqualFold(part, o) {
+				rest := append(parts[:i:i], parts[i+1:]...)
+				return o, strings.Join(rest, "/")
+			}
+		}
+	}
+	return "", name
+}
+
+func isLower(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
@@ -0,0 +1,183 @@
+package report
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const textOutput = `goos: darwin
+goarch: amd64
+pkg: goapi/find_bench
+cpu: Intel(R) Core(TM) i7-8700 CPU @ 3.20GHz
+BenchmarkJormFindByID-12    	   43880	     27346 ns/op	    2203 B/op	      53 allocs/op
+BenchmarkGormFindByID-12    	   37935	     31626 ns/op	    3762 B/op	      63 allocs/op
+BenchmarkXormFindByID-12    	   35203	     34082 ns/op	    4295 B/op	     121 allocs/op
+BenchmarkJormLogger/level=info-12	1000	1000 ns/op	2.000 queries/op	10 B/op	1 allocs/op
+PASS
+ok  	goapi/find_bench	5.123s
+`
+
+func TestParseText(t *testing.T) {
+	set, err := Parse(strings.NewReader(textOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := set.Config["cpu"]; got != "Intel(R) Core(TM) i7-8700 CPU @ 3.20GHz" {
+		t.Errorf("cpu = %q", got)
+	}
+	if len(set.Results) != 4 {
+		t.Fatalf("got %d results, want 4", len(set.Results))
+	}
+
+	r := set.Results[1]
+	if r.ORM != ORMGorm || r.Scenario != "FindByID" || r.Procs != 12 || r.N != 37935 {
+		t.Errorf("unexpected result %+v", r)
+	}
+	if r.NsPerOp != 31626 || r.BytesPerOp != 3762 || r.AllocsPerOp != 63 {
+		t.Errorf("unexpected metrics %+v", r)
+	}
+	if r.Package != "goapi/find_bench" {
+		t.Errorf("package = %q", r.Package)
+	}
+
+	sub := set.Results[3]
+	if sub.ORM != ORMJorm || sub.Scenario != "Logger/level=info" || sub.Metrics["queries/op"] != 2 {
+		t.Errorf("unexpected sub benchmark %+v", sub)
+	}
+}
+
+func TestParseLineRejectsSkipped(t *testing.T) {
+	for _, line := range []string{
+		"BenchmarkJormFirstModel \t       0\t       NaN ns/op",
+		"BenchmarkJormFirstModel-8 \t       0\t         0 ns/op",
+		"BenchmarkJormFirstModel \t      10\t      1000 ns/op\t      +Inf meta-B/model",
+	} {
+		if r, ok := ParseLine(line); ok {
+			t.Errorf("ParseLine(%q) = %+v, want rejected", line, r)
+		}
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	events := `{"Action":"start","Package":"goapi/create_bench"}
+{"Action":"output","Package":"goapi/create_bench","Output":"BenchmarkJormInsert\n"}
+{"Action":"output","Package":"goapi/create_bench","Test":"BenchmarkJormInsert","Output":"BenchmarkJormInsert-8 \t"}
+{"Action":"output","Package":"goapi/create_bench","Test":"BenchmarkJormInsert","Output":"    2128\t    469885 ns/op\t    1884 B/op\t      36 allocs/op\n"}
+{"Action":"pass","Package":"goapi/create_bench"}
+`
+	set, err := Parse(strings.NewReader(events))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(set.Results) != 1 {
+		t.Fatalf("got %d results, want 1", len(set.Results))
+	}
+	r := set.Results[0]
+	if r.Package != "goapi/create_bench" || r.Scenario != "Insert" || r.NsPerOp != 469885 {
+		t.Errorf("unexpected result %+v", r)
+	}
+}
+
+func TestSplitName(t *testing.T) {
+	tests := []struct {
+		name, orm, scenario string
+	}{
+		{"JormInsert", ORMJorm, "Insert"},
+		{"XormUpdateAll", ORMXorm, "UpdateAll"},
+		{"RawFindByID/size=1000", ORMRaw, "FindByID/size=1000"},
+		{"Logger/gorm/level=warn", ORMGorm, "Logger/level=warn"},
+		{"Jormish", "", "Jormish"},
+	}
+	for _, tt := range tests {
+		orm, scenario := SplitName(tt.name)
+		if orm != tt.orm || scenario != tt.scenario {
+			t.Errorf("SplitName(%q) = %q, %q; want %q, %q", tt.name, orm, scenario, tt.orm, tt.scenario)
+		}
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	set, err := Parse(strings.NewReader(textOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteMarkdown(&buf, Group(set.Results)); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"| **按 ID 查询** | JORM | 36,568 | 27,346 | 2,203 | 53 | - |",
+		"| | GORM | 31,620 | 31,626 | 3,762 | 63 | +15.7% |",
+		"按 ID 查询：JORM 的 QPS 比 GORM 高约 15.7%，比 XORM 高约 24.6%",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown missing %q\n%s", want, out)
+		}
+	}
+}
+
+func TestFormatInt(t *testing.T) {
+	for in, want := range map[float64]string{0: "0", 999: "999", 1000: "1,000", 469885.4: "469,885", -1234567: "-1,234,567"} {
+		if got := FormatInt(in); got != want {
+			t.Errorf("FormatInt(%v) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestWriteTextRoundTrip(t *testing.T) {
+	set, err := Parse(strings.NewReader(textOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteText(&buf, set); err != nil {
+		t.Fatal(err)
+	}
+	text := buf.String()
+	again, err := Parse(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Results) != len(set.Results) || again.Config["cpu"] != set.Config["cpu"] {
+		t.Fatalf("round trip lost data:\n%s", text)
+	}
+	for i, r := range again.Results {
+		want := set.Results[i]
+		if r.Name != want.Name || r.Procs != want.Procs || r.NsPerOp != want.NsPerOp ||
+			r.AllocsPerOp != want.AllocsPerOp || r.Package != want.Package || r.Metrics["queries/op"] != want.Metrics["queries/op"] {
+			t.Errorf("result %d = %+v, want %+v", i, r, want)
+		}
+	}
+}
+
+func TestWriteReadmeEnv(t *testing.T) {
+	set, err := Parse(strings.NewReader("go: go1.25.4\nsqlite: 3.51.1\njorm: v1.0.0-alpha.6\n" + textOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "README.md")
+	readme := "## 性能\n" + MarkerBegin + "\nold\n" + MarkerEnd + "\n## 测试环境\n" + EnvMarkerBegin + "\n- Go 1.18+\n" + EnvMarkerEnd + "\n"
+	if err := os.WriteFile(path, []byte(readme), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	table := Group(set.Results)
+	table.Env = set.Config
+	if err := WriteReadme(path, table); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(path)
+	out := string(data)
+	for _, want := range []string{"- **Go 版本**: go1.25.4", "- **操作系统**: darwin", "- **jorm**: v1.0.0-alpha.6", "| **按 ID 查询** | JORM |"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("readme missing %q\n%s", want, out)
+		}
+	}
+	// 有环境标记时表格后面不再重复输出测试环境
+	if strings.Contains(out, "Go 1.18+") || strings.Count(out, "Go 版本") != 1 {
+		t.Errorf("environment not replaced exactly once\n%s", out)
+	}
+}
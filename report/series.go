@@ -0,0 +1,70 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Series 是一组带标签的结果，例如某个 jorm 版本的全部运行
+type Series struct {
+	Label   string
+	Results []Result
+}
+
+// WriteSeries 输出 "版本 × 场景" 对比表：每个版本一列 ns/op 与 allocs/op，
+// 第一个版本之后的每个版本附带相对第一个版本的 ns/op 变化 (正数表示变慢)，
+// 多次运行时不显著的变化标记为 "~"
+func WriteSeries(w io.Writer, series []Series, alpha float64) error {
+	if len(series) == 0 {
+		return nil
+	}
+	tables := make([]*Table, len(series))
+	var all []Result
+	for i, s := range series {
+		tables[i] = Group(s.Results)
+		all = append(all, s.Results...)
+	}
+	// 合并后的表只用于确定场景与 ORM 的顺序
+	merged := Group(all)
+
+	bw := bufio.NewWriter(w)
+	header := []string{"操作类型", "ORM 框架"}
+	for i, s := range series {
+		header = append(header, s.Label+" ns/op", s.Label+" allocs/op")
+		if i > 0 {
+			header = append(header, s.Label+" 对比 "+series[0].Label)
+		}
+	}
+	fmt.Fprintln(bw, "| "+strings.Join(header, " | ")+" |")
+	fmt.Fprintln(bw, "|"+strings.Repeat("---|", len(header)))
+
+	for _, sc := range merged.Scenarios {
+		for _, orm := range merged.ORMs {
+			if merged.Cell(sc, orm) == nil {
+				continue
+			}
+			row := []string{ScenarioLabel(sc), ormLabel(orm)}
+			base := tables[0].Cell(sc, orm)
+			for i, t := range tables {
+				c := t.Cell(sc, orm)
+				if c == nil {
+					row = append(row, "-", "-")
+				} else {
+					row = append(row, FormatInt(c.NsPerOp), FormatInt(c.AllocsPerOp))
+				}
+				if i == 0 {
+					continue
+				}
+				if c == nil || base == nil {
+					row = append(row, "-")
+					continue
+				}
+				row = append(row, Compare(base.Samples(MetricNs), c.Samples(MetricNs), alpha).String())
+			}
+			fmt.Fprintln(bw, "| "+strings.Join(row, " | ")+" |")
+		}
+	}
+	return bw.Flush()
+}
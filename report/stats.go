@@ -0,0 +1,235 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// DefaultAlpha 显著性检验的默认显著性水平
+const DefaultAlpha = 0.05
+
+// Summary 是一组样本 (同一 benchmark 多次 -count 运行) 的描述统计
+type Summary struct {
+	N      int     `json:"n"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	Stddev float64 `json:"stddev"`
+	// CILow / CIHigh 是均值的 95% 置信区间 (t 分布)，样本数不足 2 时等于均值
+	CILow  float64 `json:"ci_low"`
+	CIHigh float64 `json:"ci_high"`
+}
+
+// Summarize 计算样本的均值、中位数、样本标准差与 95% 置信区间
+func Summarize(xs []float64) Summary {
+	s := Summary{N: len(xs)}
+	if s.N == 0 {
+		return s
+	}
+	sorted := slices.Clone(xs)
+	slices.Sort(sorted)
+	if s.N%2 == 1 {
+		s.Median = sorted[s.N/2]
+	} else {
+		s.Median = (sorted[s.N/2-1] + sorted[s.N/2]) / 2
+	}
+
+	for _, x := range xs {
+		s.Mean += x
+	}
+	s.Mean /= float64(s.N)
+	s.CILow, s.CIHigh = s.Mean, s.Mean
+	if s.N < 2 {
+		return s
+	}
+
+	var ss float64
+	for _, x := range xs {
+		ss += (x - s.Mean) * (x - s.Mean)
+	}
+	s.Stddev = math.Sqrt(ss / float64(s.N-1))
+	half := tQuantile975(s.N-1) * s.Stddev / math.Sqrt(float64(s.N))
+	s.CILow, s.CIHigh = s.Mean-half, s.Mean+half
+	return s
+}
+
+// RelCI 返回置信区间半宽相对均值的比例，用于输出 "±2%"
+func (s Summary) RelCI() float64 {
+	if s.Mean == 0 {
+		return 0
+	}
+	return (s.CIHigh - s.Mean) / s.Mean
+}
+
+// t975 是 t 分布 0.975 分位数，下标为自由度 1..30
+var t975 = []float64{
+	0, 12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+	2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+	2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+}
+
+func tQuantile975(df int) float64 {
+	switch {
+	case df < 1:
+		return math.NaN()
+	case df < len(t975):
+		return t975[df]
+	case df <= 60:
+		return 2.000
+	case df <= 120:
+		return 1.980
+	default:
+		return 1.960
+	}
+}
+
+// MannWhitneyU 对两组独立样本做双侧 Mann-Whitney U 检验，返回 x 的 U 统计量和 p 值。
+// 样本较少且没有相同值时使用精确分布，否则使用带结校正的正态近似
+func MannWhitneyU(x, y []float64) (u, p float64) {
+	n1, n2 := len(x), len(y)
+	if n1 == 0 || n2 == 0 {
+		return 0, 1
+	}
+
+	type obs struct {
+		v     float64
+		fromX bool
+	}
+	all := make([]obs, 0, n1+n2)
+	for _, v := range x {
+		all = append(all, obs{v, true})
+	}
+	for _, v := range y {
+		all = append(all, obs{v, false})
+	}
+	slices.SortFunc(all, func(a, b obs) int {
+		switch {
+		case a.v < b.v:
+			return -1
+		case a.v > b.v:
+			return 1
+		}
+		return 0
+	})
+
+	// 计算秩和，相同值取平均秩，同时累计结校正项 sum(t^3 - t)
+	var rankX, tieTerm float64
+	for i := 0; i < len(all); {
+		j := i
+		for j < len(all) && all[j].v == all[i].v {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if all[k].fromX {
+				rankX += rank
+			}
+		}
+		if t := float64(j - i); t > 1 {
+			tieTerm += t*t*t - t
+		}
+		i = j
+	}
+	u = rankX - float64(n1*(n1+1))/2
+
+	if tieTerm == 0 && n1+n2 <= 50 {
+		return u, exactMannWhitneyP(n1, n2, u)
+	}
+
+	mean := float64(n1*n2) / 2
+	n := float64(n1 + n2)
+	variance := float64(n1*n2) / 12 * ((n + 1) - tieTerm/(n*(n-1)))
+	if variance <= 0 {
+		return u, 1
+	}
+	// 连续性校正
+	z := (math.Abs(u-mean) - 0.5) / math.Sqrt(variance)
+	if z < 0 {
+		z = 0
+	}
+	p = math.Erfc(z / math.Sqrt2)
+	return u, math.Min(p, 1)
+}
+
+// exactMannWhitneyP 根据 U 的精确分布计算双侧 p 值
+func exactMannWhitneyP(n1, n2 int, u float64) float64 {
+	maxU := n1 * n2
+	// counts[m][n][k] 通过递推 f(m,n,k) = f(m-1,n,k-n) + f(m,n-1,k) 滚动计算
+	prev := make([][]float64, n2+1)
+	for n := range prev {
+		prev[n] = make([]float64, maxU+1)
+		prev[n][0] = 1
+	}
+	for m := 1; m <= n1; m++ {
+		cur := make([][]float64, n2+1)
+		cur[0] = make([]float64, maxU+1)
+		cur[0][0] = 1
+		for n := 1; n <= n2; n++ {
+			cur[n] = make([]float64, maxU+1)
+			for k := 0; k <= m*n; k++ {
+				if k >= n {
+					cur[n][k] += prev[n][k-n]
+				}
+				cur[n][k] += cur[n-1][k]
+			}
+		}
+		prev = cur
+	}
+	dist := prev[n2]
+
+	var total float64
+	for _, c := range dist {
+		total += c
+	}
+	// 以较小的一侧尾部概率乘 2 作为双侧 p 值
+	lo := math.Min(u, float64(maxU)-u)
+	var tail float64
+	for k := 0; float64(k) <= lo; k++ {
+		tail += dist[k]
+	}
+	return math.Min(2*tail/total, 1)
+}
+
+// Delta 描述两组样本之间的相对变化及其显著性
+type Delta struct {
+	// Ratio = mean(cur) / mean(base) - 1
+	Ratio float64 `json:"ratio"`
+	P     float64 `json:"p"`
+	// Tested 两侧都至少有 2 个样本时才做显著性检验，单次运行只能给出原始差异
+	Tested      bool `json:"tested"`
+	Significant bool `json:"significant"`
+}
+
+// Compare 比较 base 与 cur 两组样本，p < alpha 时认为差异显著
+func Compare(base, cur []float64, alpha float64) Delta {
+	b, c := Summarize(base), Summarize(cur)
+	var d Delta
+	if b.Mean != 0 {
+		d.Ratio = c.Mean/b.Mean - 1
+	}
+	if b.N < 2 || c.N < 2 {
+		d.P = 1
+		return d
+	}
+	_, d.P = MannWhitneyU(base, cur)
+	d.Tested = true
+	d.Significant = d.P < alpha
+	return d
+}
+
+// Insignificant 经过检验且差异不显著
+func (d Delta) Insignificant() bool {
+	return d.Tested && !d.Significant
+}
+
+// String 输出 "+15.7% (p=0.008)"，差异不显著时输出 "~ (p=0.310)"，未检验时只输出百分比
+func (d Delta) String() string {
+	switch {
+	case !d.Tested:
+		return FormatPercent(d.Ratio)
+	case !d.Significant:
+		return fmt.Sprintf("~ (p=%.3f)", d.P)
+	default:
+		return fmt.Sprintf("%s (p=%.3f)", FormatPercent(d.Ratio), d.P)
+	}
+}
@@ -0,0 +1,97 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func almostEqual(a, b, eps float64) bool {
+	return math.Abs(a-b) <= eps
+}
+
+func TestSummarize(t *testing.T) {
+	s := Summarize([]float64{5, 1, 4, 2, 3})
+	if s.N != 5 || s.Mean != 3 || s.Median != 3 {
+		t.Fatalf("unexpected summary %+v", s)
+	}
+	if !almostEqual(s.Stddev, 1.5811, 1e-4) {
+		t.Errorf("stddev = %v", s.Stddev)
+	}
+	// t(0.975, 4) = 2.776
+	if !almostEqual(s.CIHigh-s.Mean, 2.776*1.5811/math.Sqrt(5), 1e-3) {
+		t.Errorf("ci = [%v, %v]", s.CILow, s.CIHigh)
+	}
+
+	one := Summarize([]float64{42})
+	if one.CILow != 42 || one.CIHigh != 42 || one.Stddev != 0 {
+		t.Errorf("single sample summary %+v", one)
+	}
+}
+
+func TestMannWhitneyU(t *testing.T) {
+	// 完全分离的两组 4 个样本，精确双侧 p = 2/70
+	u, p := MannWhitneyU([]float64{1, 2, 3, 4}, []float64{5, 6, 7, 8})
+	if u != 0 || !almostEqual(p, 2.0/70, 1e-9) {
+		t.Errorf("u=%v p=%v", u, p)
+	}
+
+	_, p = MannWhitneyU([]float64{1, 3, 5, 7}, []float64{2, 4, 6, 8})
+	if p < 0.5 {
+		t.Errorf("interleaved samples should not be significant, p=%v", p)
+	}
+
+	// 全部相同值时方差为 0，不可能显著
+	_, p = MannWhitneyU([]float64{36, 36, 36}, []float64{36, 36, 36})
+	if p != 1 {
+		t.Errorf("identical samples p=%v", p)
+	}
+
+	// 有结时走正态近似
+	_, p = MannWhitneyU([]float64{1, 1, 2, 2, 3, 3}, []float64{7, 7, 8, 8, 9, 9})
+	if p >= 0.05 {
+		t.Errorf("separated samples with ties should be significant, p=%v", p)
+	}
+}
+
+func TestWriteMarkdownMultiRun(t *testing.T) {
+	var lines []string
+	for i := 0; i < 5; i++ {
+		// jorm 明显快于 gorm，与 xorm 交错
+		lines = append(lines,
+			fmt.Sprintf("BenchmarkJormFindByID-8 1000 %d ns/op 2203 B/op 53 allocs/op", 27000+i*100),
+			fmt.Sprintf("BenchmarkGormFindByID-8 1000 %d ns/op 3762 B/op 63 allocs/op", 31000+i*100),
+			fmt.Sprintf("BenchmarkXormFindByID-8 1000 %d ns/op 4295 B/op 121 allocs/op", 27050+i*100),
+		)
+	}
+	set, err := Parse(strings.NewReader(strings.Join(lines, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	table := Group(set.Results)
+	if !table.MultiRun() {
+		t.Fatal("expected multi run table")
+	}
+
+	d, _ := table.Relative("FindByID", ORMGorm)
+	if !d.Tested || !d.Significant || d.Ratio <= 0 {
+		t.Errorf("jorm vs gorm: %+v", d)
+	}
+	d, _ = table.Relative("FindByID", ORMXorm)
+	if !d.Insignificant() {
+		t.Errorf("jorm vs xorm should be insignificant: %+v", d)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteMarkdown(&buf, table); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{"| | XORM |", "~ (p=", "与 XORM 无显著差异", "ns/op 统计明细"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown missing %q\n%s", want, out)
+		}
+	}
+}
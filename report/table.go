@@ -0,0 +1,166 @@
+package report
+
+import (
+	"slices"
+	"strings"
+)
+
+// scenarioLabels 已知场景在报告中的中文名称，未登记的场景直接使用场景名
+var scenarioLabels = map[string]string{
+	"Insert":            "插入",
+	"FindByID":          "按 ID 查询",
+	"FindLimit":         "分页查询 (Limit 100)",
+	"FindAll":           "全表查询",
+	"UpdateByID":        "按 ID 更新",
+	"UpdateByCondition": "条件更新",
+	"UpdateAll":         "全表更新",
+}
+
+// ScenarioLabel 返回场景在报告中的展示名称
+func ScenarioLabel(scenario string) string {
+	if label, ok := scenarioLabels[scenario]; ok {
+		return label
+	}
+	return scenario
+}
+
+// Cell 是同一场景、同一 ORM 下所有结果的汇总
+type Cell struct {
+	Scenario string
+	ORM      string
+	Runs     []Result
+
+	// NsPerOp 等字段是多次运行的均值，Ns 等字段是对应的描述统计
+	NsPerOp     float64
+	BytesPerOp  float64
+	AllocsPerOp float64
+	Ns          Summary
+	Bytes       Summary
+	Allocs      Summary
+}
+
+// Samples 返回该组每次运行的指定指标，metric 为 ns/op、B/op、allocs/op 或自定义指标单位
+func (c *Cell) Samples(metric string) []float64 {
+	xs := make([]float64, 0, len(c.Runs))
+	for _, r := range c.Runs {
+		xs = append(xs, r.Metric(metric))
+	}
+	return xs
+}
+
+// QPS 根据平均 ns/op 换算每秒操作次数
+func (c *Cell) QPS() float64 {
+	if c.NsPerOp <= 0 {
+		return 0
+	}
+	return 1e9 / c.NsPerOp
+}
+
+// Table 按场景、ORM 分组后的结果
+type Table struct {
+	// Alpha 是 ORM 之间显著性检验的显著性水平，默认为 DefaultAlpha
+	Alpha float64
+	// MaxCV 是 ns/op 变异系数的上限，多次运行时超过上限或差异小于噪声的分组会在报告中列出
+	MaxCV float64
+	// Env 是结果集头部的环境信息，非空时报告末尾附上"测试环境"
+	Env map[string]string
+
+	// Scenarios 按首次出现的顺序排列
+	Scenarios []string
+	// ORMs 按 KnownORMs 顺序排列，未知 ORM 排在最后
+	ORMs  []string
+	cells map[string]map[string]*Cell
+}
+
+// Group 按场景与 ORM 分组，同组多次运行 (-count) 的结果作为样本做描述统计。
+// 识别不出 ORM 的结果会被忽略
+func Group(results []Result) *Table {
+	t := &Table{Alpha: DefaultAlpha, MaxCV: DefaultMaxCV, cells: make(map[string]map[string]*Cell)}
+	for _, r := range results {
+		if r.ORM == "" {
+			continue
+		}
+		byORM, ok := t.cells[r.Scenario]
+		if !ok {
+			byORM = make(map[string]*Cell)
+			t.cells[r.Scenario] = byORM
+			t.Scenarios = append(t.Scenarios, r.Scenario)
+		}
+		c, ok := byORM[r.ORM]
+		if !ok {
+			c = &Cell{Scenario: r.Scenario, ORM: r.ORM}
+			byORM[r.ORM] = c
+			if !slices.Contains(t.ORMs, r.ORM) {
+				t.ORMs = append(t.ORMs, r.ORM)
+			}
+		}
+		c.Runs = append(c.Runs, r)
+	}
+
+	for _, byORM := range t.cells {
+		for _, c := range byORM {
+			c.Ns = Summarize(c.Samples(MetricNs))
+			c.Bytes = Summarize(c.Samples(MetricBytes))
+			c.Allocs = Summarize(c.Samples(MetricAllocs))
+			c.NsPerOp, c.BytesPerOp, c.AllocsPerOp = c.Ns.Mean, c.Bytes.Mean, c.Allocs.Mean
+		}
+	}
+	slices.SortStableFunc(t.ORMs, func(a, b string) int {
+		return ormRank(a) - ormRank(b)
+	})
+	return t
+}
+
+func ormRank(orm string) int {
+	if i := slices.Index(KnownORMs, orm); i >= 0 {
+		return i
+	}
+	return len(KnownORMs)
+}
+
+// Cell 返回指定场景和 ORM 的汇总，不存在时返回 nil
+func (t *Table) Cell(scenario, orm string) *Cell {
+	return t.cells[scenario][orm]
+}
+
+// Relative 返回 jorm 相对 other 的 QPS 提升，Ratio 为 0.156 表示 jorm 快 15.6%。
+// 两侧都有多次运行时基于 ns/op 样本做 Mann-Whitney U 检验，任意一方缺失时 ok 为 false
+func (t *Table) Relative(scenario, other string) (d Delta, ok bool) {
+	base, cmp := t.Cell(scenario, ORMJorm), t.Cell(scenario, other)
+	if base == nil || cmp == nil || base.NsPerOp == 0 {
+		return Delta{}, false
+	}
+	// QPS(jorm)/QPS(other) - 1 等于 ns(other)/ns(jorm) - 1
+	return Compare(base.Samples(MetricNs), cmp.Samples(MetricNs), t.Alpha), true
+}
+
+// HasMetric 是否有结果上报了指定的自定义指标
+func (t *Table) HasMetric(unit string) bool {
+	for _, byORM := range t.cells {
+		for _, c := range byORM {
+			for _, r := range c.Runs {
+				if _, ok := r.Metrics[unit]; ok {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// MultiRun 是否存在多次运行的分组，存在时报告会附带统计明细
+func (t *Table) MultiRun() bool {
+	for _, byORM := range t.cells {
+		for _, c := range byORM {
+			if len(c.Runs) > 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ormLabel 返回 ORM 在报告中的展示名称，例如 JORM
+func ormLabel(orm string) string {
+	return strings.ToUpper(orm)
+}
@@ -0,0 +1,49 @@
+package report
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteToggle 对名称中带 key=off / key=on 子项的场景 (例如 StmtFindByID/stmt=off)，
+// 把同一 ORM 的两种设置放在同一行，输出 ns/op 与 prepares/op 的变化。
+// 只有 off 没有 on 的 ORM 表示不支持该开关
+func WriteToggle(w io.Writer, t *Table, key string) error {
+	off, on := "/"+key+"=off", "/"+key+"=on"
+	var bases []string
+	for _, s := range t.Scenarios {
+		if base, ok := strings.CutSuffix(s, off); ok {
+			bases = append(bases, base)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "| 操作类型 | ORM 框架 | %s=off ns/op | %s=on ns/op | ns/op 变化 | %s=off prepares/op | %s=on prepares/op |\n", key, key, key, key)
+	fmt.Fprintln(bw, "|---------|---------|-----|-----|-----|-----|-----|")
+	for _, base := range bases {
+		for _, orm := range t.ORMs {
+			a := t.Cell(base+off, orm)
+			if a == nil {
+				continue
+			}
+			row := []string{ScenarioLabel(base), ormLabel(orm), FormatInt(a.NsPerOp)}
+			b := t.Cell(base+on, orm)
+			if b == nil {
+				row = append(row, "不支持", "-", formatMetric(a, "prepares/op"), "-")
+			} else {
+				row = append(row, FormatInt(b.NsPerOp),
+					Compare(a.Samples(MetricNs), b.Samples(MetricNs), t.Alpha).String(),
+					formatMetric(a, "prepares/op"), formatMetric(b, "prepares/op"))
+			}
+			fmt.Fprintln(bw, "| "+strings.Join(row, " | ")+" |")
+		}
+	}
+	return bw.Flush()
+}
+
+func formatMetric(c *Cell, unit string) string {
+	return strconv.FormatFloat(Summarize(c.Samples(unit)).Mean, 'f', -1, 64)
+}
@@ -0,0 +1,31 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWriteToggle(t *testing.T) {
+	const out = `BenchmarkJormStmtFindByID/stmt=off	1000	20000 ns/op	0 prepares/op
+BenchmarkGormStmtFindByID/stmt=off	1000	30000 ns/op	0 prepares/op
+BenchmarkGormStmtFindByID/stmt=on	1000	24000 ns/op	0.001 prepares/op
+`
+	set, err := Parse(strings.NewReader(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteToggle(&buf, Group(set.Results), "stmt"); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		"| StmtFindByID | JORM | 20,000 | 不支持 | - | 0 | - |",
+		"| StmtFindByID | GORM | 30,000 | 24,000 | -20.0% | 0 | 0.001 |",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing row %q in:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"goapi/report"
+)
+
+// DefaultPackages 是仓库中全部 benchmark 包
+var DefaultPackages = []string{"./create_bench", "./find_bench", "./update_bench", "./logger_bench", "./stmt_bench", "./coldstart_bench", "./manymodels_bench", "./spec_bench", "./migrate_bench"}
+
+// Options 描述一次 go test -bench 调用
+type Options struct {
+	// ModuleDir 是 go.mod 所在目录，为空时使用当前目录
+	ModuleDir string
+	Packages  []string
+	// Bench 是 -bench 正则，为空时运行全部 benchmark
+	Bench     string
+	Count     int
+	Benchtime string
+	// Env 追加到子进程环境变量中，例如 GOPROXY=off
+	Env []string
+	// Stderr 接收 go 命令自身的错误输出，为空时丢弃
+	Stderr io.Writer
+}
+
+// GoTest 以 -json 模式运行 go test -bench 并解析结果，
+// modfile 非空时通过 -modfile 使用替代的 go.mod
+func GoTest(ctx context.Context, opts Options, modfile string) (*report.Set, error) {
+	args := []string{"test", "-run=^$", "-benchmem", "-json"}
+	if modfile != "" {
+		args = append(args, "-modfile="+modfile)
+	}
+	bench := opts.Bench
+	if bench == "" {
+		bench = "."
+	}
+	args = append(args, "-bench="+bench)
+	if opts.Count > 0 {
+		args = append(args, "-count="+strconv.Itoa(opts.Count))
+	}
+	if opts.Benchtime != "" {
+		args = append(args, "-benchtime="+opts.Benchtime)
+	}
+	pkgs := opts.Packages
+	if len(pkgs) == 0 {
+		pkgs = DefaultPackages
+	}
+	args = append(args, pkgs...)
+
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = opts.ModuleDir
+	cmd.Env = append(os.Environ(), opts.Env...)
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if opts.Stderr != nil {
+		cmd.Stderr = opts.Stderr
+	}
+	runErr := cmd.Run()
+
+	set, err := report.Parse(bytes.NewReader(stdout.Bytes()))
+	if err != nil {
+		return nil, fmt.Errorf("parse go test output: %w", err)
+	}
+	if runErr != nil {
+		return set, fmt.Errorf("go %s: %w%s", strings.Join(args, " "), runErr, failureOutput(&stdout))
+	}
+	return set, nil
+}
+
+// failureOutput 从 test2json 输出中挑出失败信息，便于定位 benchmark 中的 b.Fatalf
+func failureOutput(buf *bytes.Buffer) string {
+	var lines []string
+	dec := json.NewDecoder(buf)
+	for len(lines) < 20 {
+		var ev struct {
+			Action string
+			Output string
+		}
+		if err := dec.Decode(&ev); err != nil {
+			break
+		}
+		out := strings.TrimRight(ev.Output, "\n")
+		if ev.Action == "output" && (strings.Contains(out, "FAIL") || strings.Contains(out, ".go:")) {
+			lines = append(lines, out)
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
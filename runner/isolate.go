@@ -0,0 +1,151 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"goapi/report"
+)
+
+// IsolateOptions 描述一次进程隔离的运行
+type IsolateOptions struct {
+	Options
+	// Seed 决定执行顺序，相同种子得到相同的顺序，0 表示使用当前时间
+	Seed uint64
+	// Log 接收每个任务的进度，为空时丢弃
+	Log io.Writer
+}
+
+// Job 是一个在独立进程中运行的 benchmark
+type Job struct {
+	Package   string
+	Benchmark string
+	// Round 是第几轮重复 (从 0 开始)
+	Round int
+
+	bin, dir string
+}
+
+// Schedule 把 jobs 中的每个 benchmark 重复 count 轮，每轮内部随机打乱，
+// 使各轮重复交错进行，执行顺序也不会固定偏向某个 ORM。
+// 一轮的第一个与上一轮的最后一个相同时与本轮随机另一个交换，因此 (至少两个 benchmark 时) 同一 benchmark 不会连续运行
+func Schedule(jobs []Job, count int, seed uint64) []Job {
+	r := rand.New(rand.NewPCG(seed, seed^0x5851f42d4c957f2d))
+	out := make([]Job, 0, len(jobs)*count)
+	last := -1
+	for round := 0; round < count; round++ {
+		perm := r.Perm(len(jobs))
+		if len(perm) > 1 && perm[0] == last {
+			k := 1 + r.IntN(len(perm)-1)
+			perm[0], perm[k] = perm[k], perm[0]
+		}
+		if len(perm) > 0 {
+			last = perm[len(perm)-1]
+		}
+		for _, i := range perm {
+			j := jobs[i]
+			j.Round = round
+			out = append(out, j)
+		}
+	}
+	return out
+}
+
+// Isolated 为每个包编译一次测试二进制，然后每个 benchmark 的每次重复都在新的子进程中运行
+// (-test.count=1)，前一个 ORM 留下的堆、连接和缓存不会影响后一个。
+// 结果按执行顺序汇总为一个数据集，Config 中记录 isolation=process 与使用的种子
+func Isolated(ctx context.Context, opts IsolateOptions) (*report.Set, error) {
+	if opts.Seed == 0 {
+		opts.Seed = uint64(time.Now().UnixNano())
+	}
+	count := max(opts.Count, 1)
+	var filter *regexp.Regexp
+	if opts.Bench != "" {
+		var err error
+		if filter, err = regexp.Compile(opts.Bench); err != nil {
+			return nil, fmt.Errorf("bench pattern: %w", err)
+		}
+	}
+	log := opts.Log
+	if log == nil {
+		log = io.Discard
+	}
+
+	tmp, err := os.MkdirTemp("", "jormbench-isolate-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	pkgs := opts.Packages
+	if len(pkgs) == 0 {
+		pkgs = DefaultPackages
+	}
+	var jobs []Job
+	for _, pkg := range pkgs {
+		bin, pkgDir, err := buildTestBinary(ctx, opts.Options, pkg, tmp)
+		if err != nil {
+			return nil, err
+		}
+		names, err := listBenchmarks(ctx, bin, pkgDir)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pkg, err)
+		}
+		for _, name := range names {
+			if filter == nil || filter.MatchString(name) {
+				jobs = append(jobs, Job{Package: pkg, Benchmark: name, bin: bin, dir: pkgDir})
+			}
+		}
+	}
+	if len(jobs) == 0 {
+		return nil, fmt.Errorf("no benchmark matches %q", opts.Bench)
+	}
+
+	set := &report.Set{Config: map[string]string{
+		"isolation": "process",
+		"seed":      strconv.FormatUint(opts.Seed, 10),
+	}}
+	schedule := Schedule(jobs, count, opts.Seed)
+	for i, j := range schedule {
+		fmt.Fprintf(log, "[%d/%d] round %d %s %s\n", i+1, len(schedule), j.Round+1, j.Package, j.Benchmark)
+		args := []string{
+			"-test.run=^$",
+			"-test.bench=^" + regexp.QuoteMeta(j.Benchmark) + "$",
+			"-test.benchmem",
+			"-test.count=1",
+		}
+		if opts.Benchtime != "" {
+			args = append(args, "-test.benchtime="+opts.Benchtime)
+		}
+		out, err := runBinary(ctx, j.bin, j.dir, opts.Env, args)
+		if err != nil {
+			return set, fmt.Errorf("%s %s: %w", j.Package, j.Benchmark, err)
+		}
+		got, err := report.Parse(bytes.NewReader(out))
+		if err != nil {
+			return set, err
+		}
+		if len(got.Results) == 0 {
+			return set, fmt.Errorf("%s %s: no benchmark result in output:\n%s", j.Package, j.Benchmark, out)
+		}
+		for k, v := range got.Config {
+			if _, ok := set.Config[k]; !ok {
+				set.Config[k] = v
+			}
+		}
+		for _, r := range got.Results {
+			if r.Package == "" {
+				r.Package = j.Package
+			}
+			set.Results = append(set.Results, r)
+		}
+	}
+	return set, nil
+}
@@ -0,0 +1,47 @@
+package runner
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSchedule(t *testing.T) {
+	jobs := []Job{{Benchmark: "A"}, {Benchmark: "B"}, {Benchmark: "C"}}
+	got := Schedule(jobs, 4, 42)
+	if len(got) != 12 {
+		t.Fatalf("got %d jobs", len(got))
+	}
+	// 每一轮恰好包含全部 benchmark 各一次
+	for round := 0; round < 4; round++ {
+		var names []string
+		for _, j := range got[round*3 : round*3+3] {
+			if j.Round != round {
+				t.Errorf("job %+v in round %d", j, round)
+			}
+			names = append(names, j.Benchmark)
+		}
+		slices.Sort(names)
+		if !slices.Equal(names, []string{"A", "B", "C"}) {
+			t.Errorf("round %d = %v", round, names)
+		}
+	}
+	if !slices.Equal(got, Schedule(jobs, 4, 42)) {
+		t.Error("same seed produced different schedules")
+	}
+}
+
+func TestScheduleNoBackToBack(t *testing.T) {
+	jobs := []Job{{Benchmark: "A"}, {Benchmark: "B"}}
+	for seed := uint64(1); seed <= 200; seed++ {
+		got := Schedule(jobs, 10, seed)
+		for i := 1; i < len(got); i++ {
+			if got[i].Benchmark == got[i-1].Benchmark {
+				t.Fatalf("seed %d: %s runs twice in a row at %d", seed, got[i].Benchmark, i)
+			}
+		}
+	}
+	// 只有一个 benchmark 时无法避免
+	if got := Schedule(jobs[:1], 3, 1); len(got) != 3 {
+		t.Errorf("got %d jobs", len(got))
+	}
+}
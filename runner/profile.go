@@ -0,0 +1,211 @@
+package runner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"goapi/profdiff"
+	"goapi/report"
+)
+
+// ProfileOptions 描述一次按场景采集 profile 的运行
+type ProfileOptions struct {
+	Options
+	// Dir 是结果目录，保存 profile 文件与 profiles.json 清单
+	Dir string
+}
+
+// CaptureProfiles 为每个 benchmark 单独运行两次测试二进制：一次采集 CPU profile，
+// 一次以 memprofilerate=1 采集完整的分配 profile (避免采样开销干扰 CPU 数据)，
+// 结果写入 opts.Dir 并返回清单
+func CaptureProfiles(ctx context.Context, opts ProfileOptions) ([]profdiff.Capture, error) {
+	if opts.Benchtime == "" {
+		// CPU profile 每 10ms 采样一次，运行时间太短时样本不足
+		opts.Benchtime = "2s"
+	}
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, err
+	}
+	dir, err := filepath.Abs(opts.Dir)
+	if err != nil {
+		return nil, err
+	}
+	var filter *regexp.Regexp
+	if opts.Bench != "" {
+		if filter, err = regexp.Compile(opts.Bench); err != nil {
+			return nil, fmt.Errorf("bench pattern: %w", err)
+		}
+	}
+
+	pkgs := opts.Packages
+	if len(pkgs) == 0 {
+		pkgs = DefaultPackages
+	}
+	var captures []profdiff.Capture
+	for _, pkg := range pkgs {
+		bin, pkgDir, err := buildTestBinary(ctx, opts.Options, pkg, dir)
+		if err != nil {
+			return nil, err
+		}
+		names, err := listBenchmarks(ctx, bin, pkgDir)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pkg, err)
+		}
+		for _, name := range names {
+			if filter != nil && !filter.MatchString(name) {
+				continue
+			}
+			cs, err := profileBenchmark(ctx, opts, bin, pkgDir, dir, pkg, name)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", pkg, name, err)
+			}
+			captures = append(captures, cs...)
+		}
+	}
+	if err := profdiff.SaveManifest(dir, captures); err != nil {
+		return nil, err
+	}
+	return captures, nil
+}
+
+// buildTestBinary 编译包的测试二进制，返回二进制路径与包目录 (benchmark 需要在包目录下运行)
+func buildTestBinary(ctx context.Context, opts Options, pkg, outDir string) (bin, pkgDir string, err error) {
+	cmd := exec.CommandContext(ctx, "go", "list", "-f", "{{.Dir}}", pkg)
+	cmd.Dir = opts.ModuleDir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", "", fmt.Errorf("go list %s: %w", pkg, err)
+	}
+	pkgDir = strings.TrimSpace(string(out))
+
+	bin = filepath.Join(outDir, filepath.Base(pkgDir)+".test")
+	cmd = exec.CommandContext(ctx, "go", "test", "-c", "-o", bin, pkg)
+	cmd.Dir = opts.ModuleDir
+	cmd.Env = append(os.Environ(), opts.Env...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return "", "", fmt.Errorf("go test -c %s: %w: %s", pkg, err, out)
+	}
+	return bin, pkgDir, nil
+}
+
+func listBenchmarks(ctx context.Context, bin, pkgDir string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, bin, "-test.list", "^Benchmark")
+	cmd.Dir = pkgDir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		if name := strings.TrimSpace(sc.Text()); strings.HasPrefix(name, "Benchmark") {
+			names = append(names, name)
+		}
+	}
+	return names, sc.Err()
+}
+
+// profileBenchmark 采集一个顶层 benchmark。它有多个子 benchmark 时整体的 profile 无法对应到单条结果，
+// 改为对每个子 benchmark 单独采集
+func profileBenchmark(ctx context.Context, opts ProfileOptions, bin, pkgDir, dir, pkg, name string) ([]profdiff.Capture, error) {
+	c, results, err := profileOne(ctx, opts, bin, pkgDir, dir, pkg, name)
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	if len(results) == 1 {
+		return []profdiff.Capture{c}, nil
+	}
+	var captures []profdiff.Capture
+	for _, r := range results {
+		sub := "Benchmark" + r.Name
+		c, subResults, err := profileOne(ctx, opts, bin, pkgDir, dir, pkg, sub)
+		if err != nil {
+			return nil, err
+		}
+		if len(subResults) != 1 {
+			return nil, fmt.Errorf("%s: pattern %s matched %d results", sub, benchPattern(sub), len(subResults))
+		}
+		captures = append(captures, c)
+	}
+	return captures, nil
+}
+
+// profileOne 用 name 对应的模式采集一次 CPU profile 并返回全部结果；只有一条结果时再采集分配 profile，
+// 多条结果时由调用方拆分，返回的 Capture 不可用
+func profileOne(ctx context.Context, opts ProfileOptions, bin, pkgDir, dir, pkg, name string) (profdiff.Capture, []report.Result, error) {
+	base := profileBase(pkg, name)
+	c := profdiff.Capture{
+		Package:   pkg,
+		Benchmark: name,
+		CPU:       base + ".cpu.pprof",
+		Mem:       base + ".mem.pprof",
+	}
+	common := []string{
+		"-test.run=^$",
+		"-test.bench=" + benchPattern(name),
+		"-test.benchmem",
+		"-test.benchtime=" + opts.Benchtime,
+	}
+
+	out, err := runBinary(ctx, bin, pkgDir, opts.Env, append(common, "-test.cpuprofile="+filepath.Join(dir, c.CPU)))
+	if err != nil {
+		return c, nil, err
+	}
+	set, err := report.Parse(bytes.NewReader(out))
+	if err != nil {
+		return c, nil, err
+	}
+	switch len(set.Results) {
+	case 0:
+		return c, nil, fmt.Errorf("no benchmark result in output:\n%s", out)
+	case 1:
+	default:
+		os.Remove(filepath.Join(dir, c.CPU))
+		return c, set.Results, nil
+	}
+	c.Result = set.Results[0]
+	c.Result.Package = pkg
+
+	if _, err := runBinary(ctx, bin, pkgDir, opts.Env, append(common,
+		"-test.memprofile="+filepath.Join(dir, c.Mem), "-test.memprofilerate=1")); err != nil {
+		return c, nil, err
+	}
+	return c, set.Results, nil
+}
+
+// benchPattern 返回只匹配 name 的 -test.bench 模式，子 benchmark 的每一级分别锚定，
+// 例如 BenchmarkJormLogger/level=info -> ^BenchmarkJormLogger$/^level=info$
+func benchPattern(name string) string {
+	parts := strings.Split(name, "/")
+	for i, p := range parts {
+		parts[i] = "^" + regexp.QuoteMeta(p) + "$"
+	}
+	return strings.Join(parts, "/")
+}
+
+// profileBase 返回 profile 文件名的前缀，包含包路径，不同包中的同名 benchmark 不会互相覆盖，
+// 例如 ./find_bench 的 BenchmarkJormFindByID -> find_bench.JormFindByID
+func profileBase(pkg, name string) string {
+	pkg = strings.TrimPrefix(filepath.ToSlash(pkg), "./")
+	pkg = strings.NewReplacer("/", "_", ".", "_").Replace(pkg)
+	name = strings.NewReplacer("/", "_", "=", "-").Replace(strings.TrimPrefix(name, "Benchmark"))
+	return pkg + "." + name
+}
+
+func runBinary(ctx context.Context, bin, dir string, env, args []string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, bin, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return out, fmt.Errorf("%s: %w\n%s", filepath.Base(bin), err, out)
+	}
+	return out, nil
+}
@@ -0,0 +1,29 @@
+package runner
+
+import "testing"
+
+func TestProfileBase(t *testing.T) {
+	tests := []struct{ pkg, name, want string }{
+		{"./find_bench", "BenchmarkJormFindByID", "find_bench.JormFindByID"},
+		{"./create_bench", "BenchmarkJormFindByID", "create_bench.JormFindByID"},
+		{"goapi/logger_bench", "BenchmarkJormLogger/level=info", "goapi_logger_bench.JormLogger_level-info"},
+	}
+	for _, tt := range tests {
+		if got := profileBase(tt.pkg, tt.name); got != tt.want {
+			t.Errorf("profileBase(%q, %q) = %q, want %q", tt.pkg, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBenchPattern(t *testing.T) {
+	tests := []struct{ name, want string }{
+		{"BenchmarkJormFindByID", "^BenchmarkJormFindByID$"},
+		{"BenchmarkJormLogger/level=info", "^BenchmarkJormLogger$/^level=info$"},
+		{"BenchmarkX/rows=1.5k", `^BenchmarkX$/^rows=1\.5k$`},
+	}
+	for _, tt := range tests {
+		if got := benchPattern(tt.name); got != tt.want {
+			t.Errorf("benchPattern(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
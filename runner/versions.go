@@ -0,0 +1,154 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"goapi/report"
+)
+
+// Target 是一个待测试的 jorm 版本：本地源码目录 (通过 replace 引用)，
+// 或模块缓存中已经存在的版本 (通过 require 引用)
+type Target struct {
+	Label   string
+	Dir     string
+	Version string
+}
+
+// ParseTarget 解析命令行参数，支持以下写法：
+//
+//	v1.0.0-alpha.6             模块缓存中的版本
+//	../jorm                    本地源码目录
+//	alpha7=../jorm-alpha7      带自定义标签
+func ParseTarget(arg string) (Target, error) {
+	label, value, hasLabel := strings.Cut(arg, "=")
+	if !hasLabel {
+		value = arg
+	}
+	var t Target
+	if fi, err := os.Stat(value); err == nil && fi.IsDir() {
+		abs, err := filepath.Abs(value)
+		if err != nil {
+			return Target{}, err
+		}
+		t.Dir = abs
+		t.Label = filepath.Base(abs)
+	} else if strings.HasPrefix(value, "v") {
+		t.Version = value
+		t.Label = value
+	} else {
+		return Target{}, fmt.Errorf("%q is neither a directory nor a module version", value)
+	}
+	if hasLabel {
+		t.Label = label
+	}
+	return t, nil
+}
+
+// VersionRun 是某个 jorm 版本的一次完整运行结果
+type VersionRun struct {
+	Target Target
+	Set    *report.Set
+}
+
+// VersionOptions 在 Options 基础上增加离线控制
+type VersionOptions struct {
+	Options
+	// Offline 只使用模块缓存 (GOPROXY=off)，并跳过 checksum 数据库校验，
+	// 因为切换版本后临时 go.sum 中可能缺少条目
+	Offline bool
+	// Log 输出每个版本的进度，为空时不输出
+	Log io.Writer
+}
+
+// RunVersions 依次为每个目标生成临时 go.mod (通过 -modfile 传给 go test)，
+// 运行同一组 benchmark，返回按目标顺序排列的结果。各版本顺序执行，避免争用同一个 SQLite 文件
+func RunVersions(ctx context.Context, opts VersionOptions, targets []Target) ([]VersionRun, error) {
+	modDir := opts.ModuleDir
+	if modDir == "" {
+		var err error
+		if modDir, err = moduleRoot(ctx); err != nil {
+			return nil, err
+		}
+		opts.ModuleDir = modDir
+	}
+	tmp, err := os.MkdirTemp("", "jorm-versions-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	env := opts.Env
+	if opts.Offline {
+		env = append(env, "GOPROXY=off", "GOSUMDB=off", "GOFLAGS=-mod=mod")
+	}
+
+	runs := make([]VersionRun, 0, len(targets))
+	for i, t := range targets {
+		modfile, err := writeModfile(ctx, modDir, filepath.Join(tmp, fmt.Sprintf("jorm%d", i)), t, env)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.Label, err)
+		}
+		if opts.Log != nil {
+			fmt.Fprintf(opts.Log, "running benchmarks with jorm %s\n", t.Label)
+		}
+		o := opts.Options
+		o.Env = env
+		set, err := GoTest(ctx, o, modfile)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.Label, err)
+		}
+		runs = append(runs, VersionRun{Target: t, Set: set})
+	}
+	return runs, nil
+}
+
+// writeModfile 复制 go.mod / go.sum 到 base.mod / base.sum，再用 go mod edit 指向目标版本
+func writeModfile(ctx context.Context, modDir, base string, t Target, env []string) (string, error) {
+	modfile := base + ".mod"
+	if err := copyFile(filepath.Join(modDir, "go.mod"), modfile); err != nil {
+		return "", err
+	}
+	if err := copyFile(filepath.Join(modDir, "go.sum"), base+".sum"); err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+
+	args := []string{"mod", "edit", "-modfile=" + modfile, "-dropreplace=" + report.JormModule}
+	if t.Dir != "" {
+		args = append(args, "-replace="+report.JormModule+"="+t.Dir)
+	} else {
+		args = append(args, "-require="+report.JormModule+"@"+t.Version)
+	}
+	cmd := exec.CommandContext(ctx, "go", args...)
+	cmd.Dir = modDir
+	cmd.Env = append(os.Environ(), env...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("go mod edit: %w: %s", err, out)
+	}
+	return modfile, nil
+}
+
+func moduleRoot(ctx context.Context) (string, error) {
+	out, err := exec.CommandContext(ctx, "go", "env", "GOMOD").Output()
+	if err != nil {
+		return "", fmt.Errorf("go env GOMOD: %w", err)
+	}
+	gomod := strings.TrimSpace(string(out))
+	if gomod == "" || gomod == os.DevNull {
+		return "", fmt.Errorf("not inside a Go module")
+	}
+	return filepath.Dir(gomod), nil
+}
+
+func copyFile(src, dst string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dst, data, 0o644)
+}
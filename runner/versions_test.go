@@ -0,0 +1,34 @@
+package runner
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestParseTarget(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		arg                 string
+		label, dir, version string
+	}{
+		{"v1.0.0-alpha.6", "v1.0.0-alpha.6", "", "v1.0.0-alpha.6"},
+		{"alpha6=v1.0.0-alpha.6", "alpha6", "", "v1.0.0-alpha.6"},
+		{dir, filepath.Base(dir), dir, ""},
+		{"dev=" + dir, "dev", dir, ""},
+	}
+	for _, tt := range tests {
+		got, err := ParseTarget(tt.arg)
+		if err != nil {
+			t.Errorf("ParseTarget(%q): %v", tt.arg, err)
+			continue
+		}
+		if got.Label != tt.label || got.Dir != tt.dir || got.Version != tt.version {
+			t.Errorf("ParseTarget(%q) = %+v", tt.arg, got)
+		}
+	}
+
+	if _, err := ParseTarget("no-such-dir"); err == nil {
+		t.Error("expected error for unknown target")
+	}
+}
@@ -0,0 +1,247 @@
+package scenario
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shrek82/jorm"
+	"github.com/shrek82/jorm/logger"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"xorm.io/xorm"
+
+	"goapi/report"
+)
+
+// Adapter 把一个 ORM 的 CRUD 调用统一成相同的接口，
+// 每个方法的实现与 create_bench / find_bench / update_bench 中对应 benchmark 的循环体一致
+type Adapter interface {
+	Name() string
+	Insert(u *User) error
+	FindByID(id int64, u *User) error
+	FindLimit(limit int, users *[]User) error
+	FindAll(users *[]User) error
+	// Scan 按主键顺序读取 id >= startID 的 limit 条记录
+	Scan(startID int64, limit int, users *[]User) error
+	UpdateByID(id int64, u User) (int64, error)
+	UpdateByCondition(ageMin, ageMax int, u User) (int64, error)
+	UpdateAll(u User) (int64, error)
+	Close() error
+}
+
+// ErrNotFound 在按 ID 查询不到记录时返回
+var ErrNotFound = errors.New("record not found")
+
+// ORMs 是可用的 ORM 名称
+var ORMs = []string{report.ORMJorm, report.ORMGorm, report.ORMXorm}
+
+// Open 按名称打开一个 ORM 适配器
+func Open(orm string, t Target) (Adapter, error) {
+	switch orm {
+	case report.ORMJorm:
+		db, err := OpenJorm(t)
+		if err != nil {
+			return nil, err
+		}
+		return &jormAdapter{db: db}, nil
+	case report.ORMGorm:
+		db, err := OpenGorm(t)
+		if err != nil {
+			return nil, err
+		}
+		return &gormAdapter{db: db}, nil
+	case report.ORMXorm:
+		engine, err := OpenXorm(t)
+		if err != nil {
+			return nil, err
+		}
+		return &xormAdapter{engine: engine}, nil
+	}
+	return nil, fmt.Errorf("unknown orm %q", orm)
+}
+
+// OpenJorm 通过计数驱动打开 jorm，t.Debug 时以 Info 级别输出 SQL
+func OpenJorm(t Target) (*jorm.DB, error) {
+	db, err := jorm.Open(t.ORMDriver(), t.DSN, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open jorm: %w", err)
+	}
+	if t.Debug {
+		l := logger.NewStdLogger()
+		l.SetLevel(logger.LogLevelInfo)
+		db.SetLogger(l)
+	}
+	return db, nil
+}
+
+// OpenGorm 通过计数驱动打开 gorm，t.Debug 时以 Info 级别输出 SQL
+func OpenGorm(t Target) (*gorm.DB, error) {
+	var dialector gorm.Dialector
+	switch t.Driver {
+	case "mysql":
+		dialector = mysql.New(mysql.Config{DriverName: t.ORMDriver(), DSN: t.DSN})
+	default:
+		dialector = sqlite.New(sqlite.Config{DriverName: t.ORMDriver(), DSN: t.DSN})
+	}
+	cfg := &gorm.Config{}
+	if t.Debug {
+		cfg.Logger = gormlogger.Default.LogMode(gormlogger.Info)
+	}
+	db, err := gorm.Open(dialector, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("open gorm: %w", err)
+	}
+	return db, nil
+}
+
+// OpenXorm 通过计数驱动打开 xorm，t.Debug 时开启 ShowSQL
+func OpenXorm(t Target) (*xorm.Engine, error) {
+	engine, err := xorm.NewEngine(t.ORMDriver(), t.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("open xorm: %w", err)
+	}
+	engine.ShowSQL(t.Debug)
+	return engine, nil
+}
+
+type jormAdapter struct {
+	db *jorm.DB
+}
+
+func (a *jormAdapter) Name() string { return report.ORMJorm }
+
+func (a *jormAdapter) Insert(u *User) error {
+	_, err := a.db.Model(&User{}).Insert(u)
+	return err
+}
+
+func (a *jormAdapter) FindByID(id int64, u *User) error {
+	return a.db.Model(u).Where("id = ?", id).First(u)
+}
+
+func (a *jormAdapter) FindLimit(limit int, users *[]User) error {
+	return a.db.Model(&User{}).Limit(limit).Find(users)
+}
+
+func (a *jormAdapter) FindAll(users *[]User) error {
+	return a.db.Model(&User{}).Find(users)
+}
+
+func (a *jormAdapter) Scan(startID int64, limit int, users *[]User) error {
+	return a.db.Model(&User{}).Where("id >= ?", startID).OrderBy("id").Limit(limit).Find(users)
+}
+
+func (a *jormAdapter) UpdateByID(id int64, u User) (int64, error) {
+	return a.db.Model(&User{}).Where("id = ?", id).Update(u)
+}
+
+func (a *jormAdapter) UpdateByCondition(ageMin, ageMax int, u User) (int64, error) {
+	return a.db.Model(&User{}).Where("age BETWEEN ? AND ?", ageMin, ageMax).Update(u)
+}
+
+func (a *jormAdapter) UpdateAll(u User) (int64, error) {
+	return a.db.Model(&User{}).Where("1=1").Update(u)
+}
+
+func (a *jormAdapter) Close() error { return a.db.Close() }
+
+type gormAdapter struct {
+	db *gorm.DB
+}
+
+func (a *gormAdapter) Name() string { return report.ORMGorm }
+
+func (a *gormAdapter) Insert(u *User) error {
+	return a.db.Create(u).Error
+}
+
+func (a *gormAdapter) FindByID(id int64, u *User) error {
+	return a.db.Where("id = ?", id).First(u).Error
+}
+
+func (a *gormAdapter) FindLimit(limit int, users *[]User) error {
+	return a.db.Limit(limit).Find(users).Error
+}
+
+func (a *gormAdapter) FindAll(users *[]User) error {
+	return a.db.Find(users).Error
+}
+
+func (a *gormAdapter) Scan(startID int64, limit int, users *[]User) error {
+	return a.db.Where("id >= ?", startID).Order("id").Limit(limit).Find(users).Error
+}
+
+func (a *gormAdapter) UpdateByID(id int64, u User) (int64, error) {
+	res := a.db.Where("id = ?", id).Updates(&u)
+	return res.RowsAffected, res.Error
+}
+
+func (a *gormAdapter) UpdateByCondition(ageMin, ageMax int, u User) (int64, error) {
+	res := a.db.Where("age BETWEEN ? AND ?", ageMin, ageMax).Updates(&u)
+	return res.RowsAffected, res.Error
+}
+
+func (a *gormAdapter) UpdateAll(u User) (int64, error) {
+	res := a.db.Model(&User{}).Where("1=1").Updates(&u)
+	return res.RowsAffected, res.Error
+}
+
+func (a *gormAdapter) Close() error {
+	sqlDB, err := a.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+type xormAdapter struct {
+	engine *xorm.Engine
+}
+
+func (a *xormAdapter) Name() string { return report.ORMXorm }
+
+func (a *xormAdapter) Insert(u *User) error {
+	_, err := a.engine.Insert(u)
+	return err
+}
+
+func (a *xormAdapter) FindByID(id int64, u *User) error {
+	has, err := a.engine.ID(id).Get(u)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (a *xormAdapter) FindLimit(limit int, users *[]User) error {
+	return a.engine.Limit(limit).Find(users)
+}
+
+func (a *xormAdapter) FindAll(users *[]User) error {
+	return a.engine.Find(users)
+}
+
+func (a *xormAdapter) Scan(startID int64, limit int, users *[]User) error {
+	return a.engine.Where("id >= ?", startID).Asc("id").Limit(limit).Find(users)
+}
+
+func (a *xormAdapter) UpdateByID(id int64, u User) (int64, error) {
+	// Xorm 需要 ID 来定位记录
+	u.ID = id
+	return a.engine.ID(id).Update(&u)
+}
+
+func (a *xormAdapter) UpdateByCondition(ageMin, ageMax int, u User) (int64, error) {
+	return a.engine.Where("age BETWEEN ? AND ?", ageMin, ageMax).Update(&u)
+}
+
+func (a *xormAdapter) UpdateAll(u User) (int64, error) {
+	return a.engine.Table("users").Update(&u)
+}
+
+func (a *xormAdapter) Close() error { return a.engine.Close() }
@@ -0,0 +1,162 @@
+package scenario
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"goapi/report"
+	"goapi/sqlcount"
+)
+
+// Options 控制单个场景的计时方式
+type Options struct {
+	// Benchtime 是每个场景的目标运行时间，与 go test -benchtime 相同
+	Benchtime time.Duration
+	// Iterations 大于 0 时固定迭代次数，忽略 Benchtime
+	Iterations int
+	// Warmup 是每轮计时前执行但不计时的操作次数，让连接、语句与反射缓存先热起来
+	Warmup int
+	// GC 为 true 时从 runtime/metrics 采集 gc/op、gc-pause-ns/op、gc-cpu-ns/op 与 peak-heap-B
+	GC bool
+}
+
+// maxIterations 与 testing 包的上限一致
+const maxIterations = 1e9
+
+// Run 以 testing.B 相同的方式逐轮增加迭代次数，直到单轮耗时达到 Benchtime，
+// 每轮开始前重新打开 ORM 并预置数据，只对操作循环计时，返回最后一轮的结果。
+// rows 为 0 时使用场景的默认数据量
+func Run(t *Target, orm string, s Scenario, rows int, opts Options) (report.Result, error) {
+	if rows == 0 {
+		rows = s.Rows
+	}
+	if opts.Benchtime <= 0 {
+		opts.Benchtime = time.Second
+	}
+	n := 1
+	if opts.Iterations > 0 {
+		n = opts.Iterations
+	}
+	for {
+		r, elapsed, err := runOnce(t, orm, s, rows, n, opts)
+		if err != nil {
+			return report.Result{}, err
+		}
+		if opts.Iterations > 0 || elapsed >= opts.Benchtime || n >= maxIterations {
+			r.ORM, r.Scenario = orm, s.Name+variant(t, s, rows)
+			r.Name = ORMPrefix(orm) + r.Scenario
+			return r, nil
+		}
+		n = predictN(n, elapsed, opts.Benchtime)
+	}
+}
+
+// predictN 与 testing 包相同：按上一轮的耗时预测，多给 20%，每轮最多增长 100 倍
+func predictN(last int, elapsed, goal time.Duration) int {
+	prev := max(elapsed.Nanoseconds(), 1)
+	n := goal.Nanoseconds() * int64(last) / prev
+	n += n / 5
+	n = min(n, 100*int64(last))
+	n = max(n, int64(last)+1)
+	return int(min(n, maxIterations))
+}
+
+func runOnce(t *Target, orm string, s Scenario, rows, n int, opts Options) (report.Result, time.Duration, error) {
+	if err := t.Seed(rows); err != nil {
+		return report.Result{}, 0, err
+	}
+	a, err := Open(orm, *t)
+	if err != nil {
+		return report.Result{}, 0, err
+	}
+	defer a.Close()
+	reset, err := newResetter(t, s, rows)
+	if err != nil {
+		return report.Result{}, 0, err
+	}
+	defer reset.Close()
+	for i := 0; i < opts.Warmup; i++ {
+		if _, err := s.Do(a, i, rows); err != nil {
+			return report.Result{}, 0, fmt.Errorf("%s %s warmup: %w", orm, s.Name, err)
+		}
+		if err := reset.Reset(i); err != nil {
+			return report.Result{}, 0, err
+		}
+	}
+
+	runtime.GC()
+	counts := sqlcount.Default.Snapshot()
+	var (
+		gcBefore gcSnapshot
+		peak     *heapPeak
+		affected int64
+		w        stopwatch
+	)
+	if opts.GC {
+		gcBefore, peak = readGC(), startHeapPeak()
+	}
+	w.Start()
+	for i := opts.Warmup; i < opts.Warmup+n; i++ {
+		k, err := s.Do(a, i, rows)
+		if err != nil {
+			if peak != nil {
+				peak.Stop()
+			}
+			return report.Result{}, 0, fmt.Errorf("%s %s: %w", orm, s.Name, err)
+		}
+		affected += k
+		if s.Reset != nil {
+			// 与 b.StopTimer 相同，恢复数据的耗时和分配不计入结果
+			w.Stop()
+			if err := reset.Reset(i); err != nil {
+				return report.Result{}, 0, err
+			}
+			w.Start()
+		}
+	}
+	w.Stop()
+	elapsed := w.elapsed
+	var gcStats map[string]float64
+	if opts.GC {
+		gcStats = gcMetrics(gcBefore, readGC(), peak.Stop(), n)
+	}
+
+	r := report.Result{
+		Procs:       runtime.GOMAXPROCS(0),
+		N:           n,
+		NsPerOp:     float64(elapsed.Nanoseconds()) / float64(n),
+		BytesPerOp:  float64(w.bytes) / float64(n),
+		AllocsPerOp: float64(w.allocs) / float64(n),
+		Metrics:     sqlcount.Metrics(sqlcount.Default.Snapshot().Sub(counts), n),
+	}
+	for k, v := range gcStats {
+		r.Metrics[k] = v
+	}
+	if s.Affected != nil {
+		r.Metrics[RowsAffectedMetric] = float64(affected) / float64(n)
+	}
+	return r, elapsed, nil
+}
+
+// variant 生成子 benchmark 形式的名称后缀：数据量不同于默认值时加 /size=N，
+// 非默认存储模式时加 /storage=X，使不同组合在报告中是不同的场景
+func variant(t *Target, s Scenario, rows int) string {
+	var suffix string
+	if rows != s.Rows {
+		suffix += fmt.Sprintf("/size=%d", rows)
+	}
+	if t.Storage != "" && t.Storage != StorageFile {
+		suffix += "/storage=" + t.Storage
+	}
+	return suffix
+}
+
+// ORMPrefix 返回 benchmark 名称中的 ORM 前缀，例如 jorm -> Jorm，名称为前缀加场景
+func ORMPrefix(orm string) string {
+	if orm == "" {
+		return ""
+	}
+	return strings.ToUpper(orm[:1]) + orm[1:]
+}
@@ -0,0 +1,79 @@
+package scenario
+
+import (
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/shrek82/jorm"
+	"gorm.io/gorm"
+	"xorm.io/xorm"
+
+	"goapi/config"
+	"goapi/envinfo"
+)
+
+// BenchTarget 是 *_bench 包共用的连接：读取 jormbench.yaml (找不到时使用当前目录的 SQLite 数据库 test.db)，
+// 环境变量 BENCH_DSN 与 JORMBENCH_* 可以覆盖其中的配置，每个进程只加载一次
+var BenchTarget = sync.OnceValues(func() (*Target, error) {
+	cfg, err := config.LoadDefault()
+	if err != nil {
+		return nil, err
+	}
+	return NewTarget(cfg, StorageFile, "test.db")
+})
+
+// BenchSQLDB 返回 BenchTarget 的底层 *sql.DB (不计数)，主要用于在 benchmark 中做 DELETE 等操作
+func BenchSQLDB() (*sql.DB, error) {
+	t, err := BenchTarget()
+	if err != nil {
+		return nil, err
+	}
+	return sql.Open(t.Driver, t.DSN)
+}
+
+// BenchJorm 用 BenchTarget 打开 jorm，见 OpenJorm
+func BenchJorm() (*jorm.DB, error) {
+	t, err := BenchTarget()
+	if err != nil {
+		return nil, err
+	}
+	return OpenJorm(*t)
+}
+
+// BenchGorm 用 BenchTarget 打开 gorm，见 OpenGorm
+func BenchGorm() (*gorm.DB, error) {
+	t, err := BenchTarget()
+	if err != nil {
+		return nil, err
+	}
+	return OpenGorm(*t)
+}
+
+// BenchXorm 用 BenchTarget 打开 xorm，见 OpenXorm
+func BenchXorm() (*xorm.Engine, error) {
+	t, err := BenchTarget()
+	if err != nil {
+		return nil, err
+	}
+	return OpenXorm(*t)
+}
+
+// BenchMain 是 *_bench 包 TestMain 的实现，返回值交给 os.Exit。
+// 运行 benchmark 时先输出环境头部 (Go / SQLite / ORM 版本、数据库模式、git 提交)，report 会把这些键值记录到结果集中；
+// 配置无效时直接报错退出，而不是让每个 benchmark 各自失败。
+// m 是 *testing.M，这里只依赖 Run 方法，CLI 链接 scenario 时不会引入 testing
+func BenchMain(m interface{ Run() int }) int {
+	flag.Parse()
+	if f := flag.Lookup("test.bench"); f != nil && f.Value.String() != "" {
+		t, err := BenchTarget()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "jormbench:", err)
+			return 1
+		}
+		envinfo.PrintHeader(os.Stdout, t.Mode())
+	}
+	return m.Run()
+}
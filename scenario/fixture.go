@@ -0,0 +1,165 @@
+package scenario
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// FixturesOff 作为 Target.Fixtures 时不使用模板，每次都重新插入
+const FixturesOff = "off"
+
+// fixtureVersion 在生成数据的规则变化时递增，使旧模板失效
+const fixtureVersion = 1
+
+// Fixture 描述一份预置数据：rows 条 user_1 … user_N，Seed 为 0 时 age 为 20+i%30
+// (与 benchmark 原来的 setupTestData 相同)，否则按 Seed 生成 20-49 之间的伪随机值
+type Fixture struct {
+	Rows int
+	Seed uint64
+}
+
+// ages 返回第 i 行 (从 1 开始，按顺序调用) 的 age
+func (f Fixture) ages() func(i int) int {
+	if f.Seed == 0 {
+		return func(i int) int { return 20 + i%30 }
+	}
+	r := rand.New(rand.NewPCG(f.Seed, fixtureVersion))
+	return func(int) int { return 20 + r.IntN(30) }
+}
+
+// DefaultFixtureDir 返回用户缓存目录下的 jormbench/fixtures，取不到时使用临时目录
+func DefaultFixtureDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "jormbench", "fixtures")
+}
+
+// fixtureDir 返回模板目录，不使用模板时为空
+func (t *Target) fixtureDir() string {
+	if t.Driver != "sqlite3" || t.Fixtures == FixturesOff {
+		return ""
+	}
+	if t.Fixtures == "" {
+		return DefaultFixtureDir()
+	}
+	return t.Fixtures
+}
+
+// TemplatePath 返回 f 对应的模板文件，文件名包含表结构的哈希、行数与种子，
+// 表结构或数据规则变化后自然生成新模板
+func TemplatePath(dir string, f Fixture) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s\n%d", schemas["sqlite3"], fixtureVersion))
+	return filepath.Join(dir, fmt.Sprintf("users-%s-%d-%d.db", hex.EncodeToString(sum[:6]), f.Rows, f.Seed))
+}
+
+// Restore 把数据库重置为 f 描述的数据。SQLite 第一次遇到某个 (表结构, 行数, 种子) 时生成模板文件，
+// 之后通过在线备份 API 整库复制，代价只与页数有关；复制会替换目标库中的全部表。
+// MySQL 或 Fixtures 为 off 时建表、清空后重新插入
+func (t *Target) Restore(f Fixture) error {
+	dir := t.fixtureDir()
+	if dir == "" {
+		return t.insert(f)
+	}
+	path, err := buildTemplate(dir, f)
+	if err != nil {
+		return fmt.Errorf("fixture: %w", err)
+	}
+	if err := backup(t.DSN, path); err != nil {
+		return fmt.Errorf("restore %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+// buildTemplate 返回 f 的模板文件，不存在时先写入临时文件再改名，并发生成也不会读到半成品
+func buildTemplate(dir string, f Fixture) (string, error) {
+	path := TemplatePath(dir, f)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	tmp, err := os.CreateTemp(dir, ".building-*.db")
+	if err != nil {
+		return "", err
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+
+	// 模板只需要最终内容，关闭日志与同步让百万行的插入也只需几秒
+	db, err := sql.Open("sqlite3", "file:"+tmp.Name()+"?_journal_mode=OFF&_synchronous=OFF")
+	if err != nil {
+		return "", err
+	}
+	if _, err := db.Exec(schemas["sqlite3"]); err != nil {
+		db.Close()
+		return "", fmt.Errorf("create users: %w", err)
+	}
+	if err := insertRows(db, f); err != nil {
+		db.Close()
+		return "", err
+	}
+	if err := db.Close(); err != nil {
+		return "", err
+	}
+	return path, os.Rename(tmp.Name(), path)
+}
+
+// backup 用 SQLite 在线备份 API 把 src 文件整库复制到 dsn，
+// 目标可以是文件、WAL 或共享缓存的内存数据库
+func backup(dsn, src string) error {
+	ctx := context.Background()
+	srcDB, err := sql.Open("sqlite3", "file:"+src+"?mode=ro")
+	if err != nil {
+		return err
+	}
+	defer srcDB.Close()
+	dstDB, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return err
+	}
+	defer dstDB.Close()
+
+	srcConn, err := srcDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer srcConn.Close()
+	dstConn, err := dstDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer dstConn.Close()
+
+	return dstConn.Raw(func(dc any) error {
+		return srcConn.Raw(func(sc any) error {
+			dst, ok1 := dc.(*sqlite3.SQLiteConn)
+			from, ok2 := sc.(*sqlite3.SQLiteConn)
+			if !ok1 || !ok2 {
+				return fmt.Errorf("unexpected driver connection %T", dc)
+			}
+			b, err := dst.Backup("main", from, "main")
+			if err != nil {
+				return err
+			}
+			if _, err := b.Step(-1); err != nil {
+				b.Finish()
+				return err
+			}
+			return b.Finish()
+		})
+	})
+}
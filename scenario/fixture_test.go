@@ -0,0 +1,57 @@
+package scenario
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRestoreFixture(t *testing.T) {
+	dir := t.TempDir()
+	for _, storage := range Storages {
+		target, err := SQLiteTarget(storage, filepath.Join(dir, storage+".db"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer target.Close()
+		target.Fixtures = filepath.Join(dir, "fixtures")
+
+		// 第二次 Restore 必须撤销中间的修改
+		for round := 0; round < 2; round++ {
+			if err := target.Restore(Fixture{Rows: 100}); err != nil {
+				t.Fatalf("%s: %v", storage, err)
+			}
+			db, err := sql.Open("sqlite3", target.DSN)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var n, age, seq int
+			db.QueryRow("SELECT COUNT(*) FROM users").Scan(&n)
+			db.QueryRow("SELECT age FROM users WHERE id = 7").Scan(&age)
+			db.QueryRow("SELECT seq FROM sqlite_sequence WHERE name = 'users'").Scan(&seq)
+			if n != 100 || age != 27 || seq != 100 {
+				t.Errorf("%s round %d: rows=%d age=%d seq=%d", storage, round, n, age, seq)
+			}
+			if _, err := db.Exec("DELETE FROM users WHERE id > 10"); err != nil {
+				t.Fatal(err)
+			}
+			db.Close()
+		}
+	}
+	if _, err := os.Stat(TemplatePath(filepath.Join(dir, "fixtures"), Fixture{Rows: 100})); err != nil {
+		t.Errorf("template not cached: %v", err)
+	}
+}
+
+func TestFixtureSeed(t *testing.T) {
+	a, b := Fixture{Rows: 50, Seed: 1}.ages(), Fixture{Rows: 50, Seed: 1}.ages()
+	for i := 1; i <= 50; i++ {
+		if x, y := a(i), b(i); x != y || x < 20 || x >= 50 {
+			t.Fatalf("row %d: ages %d, %d", i, x, y)
+		}
+	}
+	if TemplatePath("d", Fixture{Rows: 50, Seed: 1}) == TemplatePath("d", Fixture{Rows: 50}) {
+		t.Error("seed not part of template name")
+	}
+}
@@ -0,0 +1,104 @@
+package scenario
+
+import (
+	"math"
+	"runtime/metrics"
+	"sync"
+	"time"
+)
+
+// runtime/metrics 中与 GC 相关的指标
+const (
+	metricGCCycles  = "/gc/cycles/total:gc-cycles"
+	metricGCPauses  = "/sched/pauses/total/gc:seconds"
+	metricGCCPU     = "/cpu/classes/gc/total:cpu-seconds"
+	metricHeapObjs  = "/memory/classes/heap/objects:bytes"
+	metricHeapSlack = "/memory/classes/heap/unused:bytes"
+)
+
+// heapSampleInterval 是采样堆大小的间隔，峰值只能靠采样得到，过短会干扰被测代码
+const heapSampleInterval = time.Millisecond
+
+// gcSnapshot 是某一时刻的累计 GC 统计
+type gcSnapshot struct {
+	cycles uint64
+	// pause 由停顿时长直方图按桶中点估算
+	pause time.Duration
+	cpu   float64
+}
+
+func readGC() gcSnapshot {
+	samples := []metrics.Sample{{Name: metricGCCycles}, {Name: metricGCPauses}, {Name: metricGCCPU}}
+	metrics.Read(samples)
+	return gcSnapshot{
+		cycles: samples[0].Value.Uint64(),
+		pause:  histogramSum(samples[1].Value.Float64Histogram()),
+		cpu:    samples[2].Value.Float64(),
+	}
+}
+
+func histogramSum(h *metrics.Float64Histogram) time.Duration {
+	var sum float64
+	for i, n := range h.Counts {
+		lo, hi := h.Buckets[i], h.Buckets[i+1]
+		if math.IsInf(lo, -1) {
+			lo = hi
+		}
+		if math.IsInf(hi, 1) {
+			hi = lo
+		}
+		sum += float64(n) * (lo + hi) / 2
+	}
+	return time.Duration(sum * float64(time.Second))
+}
+
+// heapPeak 在后台按 heapSampleInterval 采样堆占用 (对象 + 未使用的 span 空间)，记录最大值
+type heapPeak struct {
+	stop chan struct{}
+	wg   sync.WaitGroup
+	peak uint64
+}
+
+func startHeapPeak() *heapPeak {
+	p := &heapPeak{stop: make(chan struct{})}
+	p.sample()
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		t := time.NewTicker(heapSampleInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-p.stop:
+				return
+			case <-t.C:
+				p.sample()
+			}
+		}
+	}()
+	return p
+}
+
+func (p *heapPeak) sample() {
+	samples := []metrics.Sample{{Name: metricHeapObjs}, {Name: metricHeapSlack}}
+	metrics.Read(samples)
+	p.peak = max(p.peak, samples[0].Value.Uint64()+samples[1].Value.Uint64())
+}
+
+// Stop 停止采样并返回峰值
+func (p *heapPeak) Stop() uint64 {
+	close(p.stop)
+	p.wg.Wait()
+	p.sample()
+	return p.peak
+}
+
+// gcMetrics 把两次快照之间的差值换算为每次操作的指标
+func gcMetrics(before, after gcSnapshot, peak uint64, n int) map[string]float64 {
+	return map[string]float64{
+		"gc/op":          float64(after.cycles-before.cycles) / float64(n),
+		"gc-pause-ns/op": float64((after.pause - before.pause).Nanoseconds()) / float64(n),
+		"gc-cpu-ns/op":   (after.cpu - before.cpu) * 1e9 / float64(n),
+		"peak-heap-B":    float64(peak),
+	}
+}
@@ -0,0 +1,122 @@
+package scenario
+
+import (
+	"database/sql"
+	"fmt"
+	"runtime"
+	"time"
+)
+
+// RowsAffectedMetric 是变更类场景上报的每次操作影响的行数
+const RowsAffectedMetric = "rows-affected/op"
+
+// Do 执行第 i 次操作并返回影响的行数。场景定义了 Affected 时检查不变量：
+// 影响行数与预置数据上的期望不同说明数据已经漂移，各 ORM 做的工作量不再相同，直接报错
+func (s Scenario) Do(a Adapter, i, rows int) (int64, error) {
+	n, err := s.Op(a, i, rows)
+	if err != nil {
+		return n, err
+	}
+	if s.Affected != nil {
+		if want := s.Affected(i, rows); n != want {
+			return n, fmt.Errorf("op %d affected %d rows, want %d: data drifted from the fixture", i, n, want)
+		}
+	}
+	return n, nil
+}
+
+// resetter 在计时之外执行场景的 Reset，场景没有 Reset 时为空操作
+type resetter struct {
+	s    Scenario
+	rows int
+	db   *sql.DB
+}
+
+func newResetter(t *Target, s Scenario, rows int) (*resetter, error) {
+	r := &resetter{s: s, rows: rows}
+	if s.Reset == nil {
+		return r, nil
+	}
+	db, err := sql.Open(t.Driver, t.DSN)
+	if err != nil {
+		return nil, err
+	}
+	r.db = db
+	return r, nil
+}
+
+// Reset 把第 i 次操作改动的数据恢复为预置状态
+func (r *resetter) Reset(i int) error {
+	if r.db == nil {
+		return nil
+	}
+	if err := r.s.Reset(r.db, i, r.rows); err != nil {
+		return fmt.Errorf("%s reset: %w", r.s.Name, err)
+	}
+	return nil
+}
+
+func (r *resetter) Close() error {
+	if r.db == nil {
+		return nil
+	}
+	return r.db.Close()
+}
+
+// stopwatch 与 testing.B 的 StartTimer / StopTimer 相同，只累计计时区间内的耗时与内存分配
+type stopwatch struct {
+	running bool
+	start   time.Time
+	elapsed time.Duration
+
+	mem           runtime.MemStats
+	startAllocs   uint64
+	startBytes    uint64
+	allocs, bytes uint64
+}
+
+func (w *stopwatch) Start() {
+	if w.running {
+		return
+	}
+	runtime.ReadMemStats(&w.mem)
+	w.startAllocs, w.startBytes = w.mem.Mallocs, w.mem.TotalAlloc
+	w.running = true
+	w.start = time.Now()
+}
+
+func (w *stopwatch) Stop() {
+	if !w.running {
+		return
+	}
+	w.elapsed += time.Since(w.start)
+	runtime.ReadMemStats(&w.mem)
+	w.allocs += w.mem.Mallocs - w.startAllocs
+	w.bytes += w.mem.TotalAlloc - w.startBytes
+	w.running = false
+}
+
+// ConditionAffected 返回 rows 行的预置数据 (age = 20+id%30) 中 age 在 [ageMin, ageMax] 内的行数，
+// 即 UpdateByCondition 在未漂移的数据上应影响的行数
+func ConditionAffected(rows, ageMin, ageMax int) int64 {
+	var n int64
+	for age := max(ageMin, 20); age <= min(ageMax, 49); age++ {
+		// id%30 == r 的 id 为 r, r+30, ...，r 为 0 时从 30 开始
+		r := age - 20
+		first := r
+		if r == 0 {
+			first = 30
+		}
+		if first <= rows {
+			n += int64((rows-first)/30 + 1)
+		}
+	}
+	return n
+}
+
+// ResetAges 把预置数据中 age 原本在 [ageMin, ageMax] 内的行恢复为 20+id%30，
+// 撤销 UpdateByCondition 对这些行的修改
+func ResetAges(db *sql.DB, ageMin, ageMax int) error {
+	_, err := db.Exec("UPDATE users SET age = 20 + id % 30 WHERE id % 30 BETWEEN ? AND ?", ageMin-20, ageMax-20)
+	return err
+}
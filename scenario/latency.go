@@ -0,0 +1,107 @@
+package scenario
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+
+	"goapi/histogram"
+	"goapi/report"
+	"goapi/sqlcount"
+)
+
+// LatencyOptions 控制延迟测试
+type LatencyOptions struct {
+	// Ops 是计入直方图的操作次数，Duration 大于 0 时改为按时间运行
+	Ops      int
+	Duration time.Duration
+	// Warmup 次操作先执行但不记录，排除首次查询时的反射缓存、连接建立等开销
+	Warmup int
+}
+
+// Latency 预置数据后连续执行场景，把每次操作的耗时记录到直方图中。
+// 返回的结果除 ns/op 等常规指标外带有 p50-ns、p99-ns 等分位数指标
+func Latency(t *Target, orm string, s Scenario, rows int, opts LatencyOptions) (report.Result, *histogram.Histogram, error) {
+	if rows == 0 {
+		rows = s.Rows
+	}
+	if opts.Ops <= 0 && opts.Duration <= 0 {
+		opts.Ops = 10000
+	}
+	if err := t.Seed(rows); err != nil {
+		return report.Result{}, nil, err
+	}
+	a, err := Open(orm, *t)
+	if err != nil {
+		return report.Result{}, nil, err
+	}
+	defer a.Close()
+	reset, err := newResetter(t, s, rows)
+	if err != nil {
+		return report.Result{}, nil, err
+	}
+	defer reset.Close()
+
+	for i := 0; i < opts.Warmup; i++ {
+		if _, err := s.Do(a, i, rows); err != nil {
+			return report.Result{}, nil, fmt.Errorf("%s %s: %w", orm, s.Name, err)
+		}
+		if err := reset.Reset(i); err != nil {
+			return report.Result{}, nil, err
+		}
+	}
+
+	h := histogram.New()
+	runtime.GC()
+	counts := sqlcount.Default.Snapshot()
+	var (
+		w        stopwatch
+		affected int64
+	)
+	w.Start()
+	n := 0
+	for ; opts.Duration > 0 || n < opts.Ops; n++ {
+		if opts.Duration > 0 && w.elapsed+time.Since(w.start) >= opts.Duration {
+			break
+		}
+		i := opts.Warmup + n
+		begin := time.Now()
+		k, err := s.Do(a, i, rows)
+		if err != nil {
+			return report.Result{}, nil, fmt.Errorf("%s %s: %w", orm, s.Name, err)
+		}
+		h.RecordDuration(time.Since(begin))
+		affected += k
+		if s.Reset != nil {
+			w.Stop()
+			if err := reset.Reset(i); err != nil {
+				return report.Result{}, nil, err
+			}
+			w.Start()
+		}
+	}
+	w.Stop()
+	elapsed := w.elapsed
+	if n == 0 {
+		return report.Result{}, nil, fmt.Errorf("%s %s: no operations completed", orm, s.Name)
+	}
+
+	r := report.Result{
+		ORM:         orm,
+		Scenario:    s.Name + variant(t, s, rows),
+		Procs:       runtime.GOMAXPROCS(0),
+		N:           n,
+		NsPerOp:     float64(elapsed.Nanoseconds()) / float64(n),
+		BytesPerOp:  float64(w.bytes) / float64(n),
+		AllocsPerOp: float64(w.allocs) / float64(n),
+		Metrics:     sqlcount.Metrics(sqlcount.Default.Snapshot().Sub(counts), n),
+	}
+	r.Name = ORMPrefix(orm) + r.Scenario
+	for k, v := range histogram.Metrics(h) {
+		r.Metrics[k] = v
+	}
+	if s.Affected != nil {
+		r.Metrics[RowsAffectedMetric] = float64(affected) / float64(n)
+	}
+	return r, h, nil
+}
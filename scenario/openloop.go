@@ -0,0 +1,146 @@
+package scenario
+
+import (
+	"fmt"
+	"runtime"
+	"time"
+
+	"goapi/histogram"
+	"goapi/report"
+	"goapi/sqlcount"
+)
+
+// SaturationRatio 是判断饱和的阈值：实际吞吐低于目标速率的这一比例时认为 ORM 已跟不上
+const SaturationRatio = 0.95
+
+// RateOptions 控制开环定速测试
+type RateOptions struct {
+	// Rate 是目标速率 (ops/s)，操作按固定间隔排期，与上一次操作何时完成无关
+	Rate     float64
+	Duration time.Duration
+	// Warmup 次操作先执行但不记录
+	Warmup int
+}
+
+// RateRun 是一个速率档位的结果
+type RateRun struct {
+	Result report.Result
+	// Latency 从计划开始时间算起，包含排队等待，已修正 coordinated omission；
+	// Service 只包含操作本身的耗时，与闭环测试的单次耗时一致
+	Latency *histogram.Histogram
+	Service *histogram.Histogram
+	// Achieved 是实际完成速率，Missed 是超时后仍未开始的计划操作数
+	Target, Achieved float64
+	Missed           int
+}
+
+// Saturated 实际吞吐跟不上目标速率或有计划操作未能执行
+func (r *RateRun) Saturated() bool {
+	return r.Missed > 0 || r.Achieved < SaturationRatio*r.Target
+}
+
+// OpenLoop 以固定速率发起操作：第 i 次操作计划在 start + i/Rate 开始，前一次操作拖慢时后续操作立即补发，
+// 延迟从计划开始时间算起，因此停顿期间本应发出的请求也会计入尾延迟。
+// 计划的操作在 2 倍 Duration 内仍未开始的计为 Missed。
+// 每个速率档位开始前 (不计时) 重新预置数据；档位内无法插入 Reset，需要 Reset 的场景直接报错，
+// 其他变更类场景与闭环测试一样检查每次操作的影响行数
+func OpenLoop(t *Target, orm string, s Scenario, rows int, opts RateOptions) (*RateRun, error) {
+	if rows == 0 {
+		rows = s.Rows
+	}
+	if opts.Rate <= 0 {
+		return nil, fmt.Errorf("rate must be positive")
+	}
+	if s.Reset != nil {
+		return nil, fmt.Errorf("scenario %s needs a reset after every operation and cannot run at a fixed rate", s.Name)
+	}
+	if opts.Duration <= 0 {
+		opts.Duration = 5 * time.Second
+	}
+	if err := t.Seed(rows); err != nil {
+		return nil, err
+	}
+	a, err := Open(orm, *t)
+	if err != nil {
+		return nil, err
+	}
+	defer a.Close()
+	for i := 0; i < opts.Warmup; i++ {
+		if _, err := s.Do(a, i, rows); err != nil {
+			return nil, fmt.Errorf("%s %s warmup: %w", orm, s.Name, err)
+		}
+	}
+
+	interval := time.Duration(float64(time.Second) / opts.Rate)
+	planned := int(opts.Rate * opts.Duration.Seconds())
+	run := &RateRun{Latency: histogram.New(), Service: histogram.New(), Target: opts.Rate}
+
+	runtime.GC()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	counts := sqlcount.Default.Snapshot()
+	start := time.Now()
+	deadline := start.Add(2 * opts.Duration)
+	n := 0
+	var affected int64
+	for ; n < planned; n++ {
+		intended := start.Add(time.Duration(n) * interval)
+		now := waitUntil(intended)
+		if now.After(deadline) {
+			break
+		}
+		k, err := s.Do(a, opts.Warmup+n, rows)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", orm, s.Name, err)
+		}
+		affected += k
+		done := time.Now()
+		run.Latency.RecordDuration(done.Sub(intended))
+		run.Service.RecordDuration(done.Sub(now))
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+	if n == 0 {
+		return nil, fmt.Errorf("%s %s: no operations completed", orm, s.Name)
+	}
+	run.Missed = planned - n
+	run.Achieved = float64(n) / elapsed.Seconds()
+
+	r := report.Result{
+		ORM:      orm,
+		Scenario: fmt.Sprintf("%s%s/rate=%g", s.Name, variant(t, s, rows), opts.Rate),
+		Procs:    runtime.GOMAXPROCS(0),
+		N:        n,
+		// ns/op 取平均服务时间，与闭环结果可比；排队后的延迟见 p99-ns 等指标
+		NsPerOp:     run.Service.Mean(),
+		BytesPerOp:  float64(after.TotalAlloc-before.TotalAlloc) / float64(n),
+		AllocsPerOp: float64(after.Mallocs-before.Mallocs) / float64(n),
+		Metrics:     sqlcount.Metrics(sqlcount.Default.Snapshot().Sub(counts), n),
+	}
+	r.Name = ORMPrefix(orm) + r.Scenario
+	for k, v := range histogram.Metrics(run.Latency) {
+		r.Metrics[k] = v
+	}
+	r.Metrics["service-p99-ns"] = float64(run.Service.Quantile(0.99))
+	r.Metrics["ops/s"] = run.Achieved
+	r.Metrics["missed"] = float64(run.Missed)
+	if s.Affected != nil {
+		r.Metrics[RowsAffectedMetric] = float64(affected) / float64(n)
+	}
+	run.Result = r
+	return run, nil
+}
+
+// waitUntil 等到 at 时刻并返回当前时间。time.Sleep 唤醒通常会晚 1ms 左右，
+// 会被算进延迟，所以只用它睡到前 2ms，剩下的时间让出 CPU 忙等；已经迟到时直接返回
+func waitUntil(at time.Time) time.Time {
+	const spin = 2 * time.Millisecond
+	now := time.Now()
+	if d := at.Sub(now); d > spin {
+		time.Sleep(d - spin)
+	}
+	for now = time.Now(); now.Before(at); now = time.Now() {
+		runtime.Gosched()
+	}
+	return now
+}
@@ -0,0 +1,122 @@
+// Package scenario 在 go test 之外运行与 create_bench / find_bench / update_bench 相同的场景，
+// 供 jormbench 命令按 ORM、数据量和存储模式组合执行
+package scenario
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// Scenario 是一个基准场景
+type Scenario struct {
+	Name string
+	// Rows 是默认预置的数据量，0 表示从空表开始
+	Rows int
+	// Op 执行第 i 次操作并返回影响的行数 (只读场景为 0)，rows 是实际预置的数据量
+	Op func(a Adapter, i, rows int) (int64, error)
+	// Affected 返回第 i 次操作在预置数据上应影响的行数，nil 表示只读场景
+	Affected func(i, rows int) int64
+	// Reset 在计时之外撤销第 i 次操作的修改，nil 表示修改不会影响后续操作的工作量
+	Reset func(db *sql.DB, i, rows int) error
+}
+
+// All 是全部场景，顺序与 README 中的性能表一致
+var All = []Scenario{
+	{Name: "Insert", Op: insert, Affected: one},
+	{Name: "FindByID", Rows: 1000, Op: findByID},
+	{Name: "FindLimit", Rows: 5000, Op: findLimit},
+	{Name: "FindAll", Rows: 1000, Op: findAll},
+	{Name: "UpdateByID", Rows: 1000, Op: updateByID, Affected: one},
+	{Name: "UpdateByCondition", Rows: 5000, Op: updateByCondition, Affected: conditionAffected, Reset: resetCondition},
+	{Name: "UpdateAll", Rows: 1000, Op: updateAll, Affected: allRows},
+}
+
+// Lookup 按名称 (不区分大小写) 查找场景
+func Lookup(name string) (Scenario, bool) {
+	for _, s := range All {
+		if strings.EqualFold(s.Name, name) {
+			return s, true
+		}
+	}
+	return Scenario{}, false
+}
+
+// Select 按逗号分隔的名称列表选择场景，空列表表示全部
+func Select(names []string) ([]Scenario, error) {
+	if len(names) == 0 {
+		return All, nil
+	}
+	var out []Scenario
+	for _, name := range names {
+		s, ok := Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown scenario %q", name)
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+func insert(a Adapter, i, _ int) (int64, error) {
+	if err := a.Insert(&User{
+		Name: fmt.Sprintf("user_%d", i),
+		Age:  20 + i%30,
+	}); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+func findByID(a Adapter, i, rows int) (int64, error) {
+	var user User
+	return 0, a.FindByID(int64(i%rows+1), &user)
+}
+
+func findLimit(a Adapter, _, _ int) (int64, error) {
+	var users []User
+	return 0, a.FindLimit(100, &users)
+}
+
+func findAll(a Adapter, _, _ int) (int64, error) {
+	var users []User
+	return 0, a.FindAll(&users)
+}
+
+func updateByID(a Adapter, i, rows int) (int64, error) {
+	return a.UpdateByID(int64(i%rows+1), User{
+		Name: fmt.Sprintf("updated_user_%d", i),
+		Age:  30 + i%20,
+	})
+}
+
+// conditionRange 是第 i 次 UpdateByCondition 匹配的 age 区间
+func conditionRange(i int) (ageMin, ageMax int) {
+	ageMin = 20 + i%10
+	return ageMin, ageMin + 5
+}
+
+func updateByCondition(a Adapter, i, _ int) (int64, error) {
+	ageMin, ageMax := conditionRange(i)
+	return a.UpdateByCondition(ageMin, ageMax, User{Age: 30 + i%20})
+}
+
+func updateAll(a Adapter, i, _ int) (int64, error) {
+	return a.UpdateAll(User{Age: 25 + i%10})
+}
+
+func one(int, int) int64 { return 1 }
+
+func allRows(_, rows int) int64 { return int64(rows) }
+
+func conditionAffected(i, rows int) int64 {
+	ageMin, ageMax := conditionRange(i)
+	return ConditionAffected(rows, ageMin, ageMax)
+}
+
+// resetCondition 把匹配行的 age 改回预置值，否则被改到 30-49 的行会在后续操作中被重复匹配，
+// 影响行数随 b.N 漂移
+func resetCondition(db *sql.DB, i, _ int) error {
+	ageMin, ageMax := conditionRange(i)
+	return ResetAges(db, ageMin, ageMax)
+}
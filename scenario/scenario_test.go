@@ -0,0 +1,130 @@
+package scenario
+
+import (
+	"testing"
+	"time"
+
+	"goapi/report"
+)
+
+func TestRunMemory(t *testing.T) {
+	target, err := SQLiteTarget(StorageMemory, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.Close()
+
+	for _, name := range []string{"Insert", "FindByID", "UpdateByID"} {
+		s, _ := Lookup(name)
+		for _, orm := range ORMs {
+			r, err := Run(target, orm, s, 20, Options{Iterations: 10})
+			if err != nil {
+				t.Fatalf("%s %s: %v", orm, name, err)
+			}
+			wantORM, wantScenario := report.SplitName(r.Name)
+			if r.N != 10 || r.ORM != wantORM || r.Scenario != wantScenario ||
+				r.Scenario != name+"/size=20/storage=memory" {
+				t.Errorf("unexpected result %+v", r)
+			}
+			if r.Metrics["queries/op"] < 1 {
+				t.Errorf("%s %s: queries/op = %v, want >= 1", orm, name, r.Metrics["queries/op"])
+			}
+		}
+	}
+}
+
+func TestSelect(t *testing.T) {
+	got, err := Select([]string{"findbyid", "Insert"})
+	if err != nil || len(got) != 2 || got[0].Name != "FindByID" || got[1].Name != "Insert" {
+		t.Fatalf("Select = %v, %v", got, err)
+	}
+	if _, err := Select([]string{"Nope"}); err == nil {
+		t.Error("expected error for unknown scenario")
+	}
+}
+
+func TestOpenLoop(t *testing.T) {
+	target, err := SQLiteTarget(StorageMemory, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.Close()
+
+	s, _ := Lookup("FindByID")
+	run, err := OpenLoop(target, "jorm", s, 20, RateOptions{Rate: 1000, Duration: 200 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.Result.N+run.Missed != 200 || run.Result.Scenario != "FindByID/size=20/storage=memory/rate=1000" {
+		t.Errorf("unexpected result %+v", run.Result)
+	}
+	// 从计划时间算起的延迟不会小于服务时间
+	if run.Latency.Count() != run.Service.Count() || run.Latency.Max() < run.Service.Max() {
+		t.Errorf("latency max %d < service max %d", run.Latency.Max(), run.Service.Max())
+	}
+}
+
+func TestOpenLoopRejectsReset(t *testing.T) {
+	s, _ := Lookup("UpdateByCondition")
+	if _, err := OpenLoop(&Target{}, "jorm", s, 0, RateOptions{Rate: 1000}); err == nil {
+		t.Fatal("OpenLoop accepted a scenario that needs Reset")
+	}
+}
+
+func TestRunGC(t *testing.T) {
+	target, err := SQLiteTarget(StorageMemory, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.Close()
+
+	s, _ := Lookup("FindAll")
+	r, err := Run(target, "jorm", s, 200, Options{Iterations: 20, GC: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []string{"gc/op", "gc-pause-ns/op", "gc-cpu-ns/op"} {
+		if _, ok := r.Metrics[m]; !ok {
+			t.Errorf("missing metric %s", m)
+		}
+	}
+	if r.Metrics["peak-heap-B"] <= 0 {
+		t.Errorf("peak-heap-B = %v", r.Metrics["peak-heap-B"])
+	}
+}
+
+func TestUpdateByConditionInvariant(t *testing.T) {
+	target, err := SQLiteTarget(StorageMemory, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.Close()
+
+	s, _ := Lookup("UpdateByCondition")
+	// 300 行时每个 age 恰好 10 行，每次匹配 6 个 age；不恢复数据时第 2 轮起影响行数会漂移
+	for _, orm := range ORMs {
+		r, err := Run(target, orm, s, 300, Options{Iterations: 40, Warmup: 5})
+		if err != nil {
+			t.Fatalf("%s: %v", orm, err)
+		}
+		if got := r.Metrics[RowsAffectedMetric]; got != 60 {
+			t.Errorf("%s: rows-affected/op = %v, want 60", orm, got)
+		}
+	}
+}
+
+func TestConditionAffected(t *testing.T) {
+	for _, rows := range []int{0, 1, 29, 30, 31, 1000, 5000} {
+		for ageMin := 18; ageMin < 50; ageMin++ {
+			var want int64
+			for id := 1; id <= rows; id++ {
+				if age := 20 + id%30; age >= ageMin && age <= ageMin+5 {
+					want++
+				}
+			}
+			if got := ConditionAffected(rows, ageMin, ageMin+5); got != want {
+				t.Fatalf("ConditionAffected(%d, %d, %d) = %d, want %d", rows, ageMin, ageMin+5, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package scenario
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
+
+	"goapi/config"
+	"goapi/envinfo"
+	"goapi/sqlcount"
+)
+
+// SQLite 的存储模式
+const (
+	StorageFile   = "file"   // 普通文件数据库 (rollback journal)
+	StorageMemory = "memory" // 共享缓存的内存数据库，排除磁盘 IO 的影响
+	StorageWAL    = "wal"    // 文件数据库 + WAL 日志模式
+)
+
+// Storages 是可用的存储模式
+var Storages = []string{StorageFile, StorageMemory, StorageWAL}
+
+// Target 描述 benchmark 连接的数据库
+type Target struct {
+	// Driver 是底层 database/sql 驱动名，用于 seed 等不计数的操作
+	Driver string
+	DSN    string
+	// Storage 是 SQLite 存储模式，仅用于报告
+	Storage string
+	// Debug 为 true 时三种 ORM 都打印执行的 SQL
+	Debug bool
+	// Fixtures 是 SQLite 预置数据模板的目录，为空时使用 DefaultFixtureDir，FixturesOff 表示不使用模板
+	Fixtures string
+
+	// keeper 让内存数据库在所有 ORM 连接关闭后仍然存活
+	keeper *sql.DB
+}
+
+// Mode 返回结果头部记录的数据库模式，例如 sqlite3/wal、mysql
+func (t Target) Mode() string {
+	if t.Storage == "" {
+		return envinfo.Mode(t.Driver)
+	}
+	return envinfo.Mode(t.Driver, t.Storage)
+}
+
+// ORMDriver 返回 ORM 应使用的驱动名：走计数驱动，以便上报 queries/op 等指标
+func (t Target) ORMDriver() string {
+	if name, err := sqlcount.Register(t.Driver); err == nil {
+		return name
+	}
+	return t.Driver
+}
+
+// NewTarget 按配置生成连接，storage 只对 SQLite 有效，defaultPath 是配置未指定 path 时的 SQLite 文件
+func NewTarget(c *config.Config, storage, defaultPath string) (*Target, error) {
+	dsn, err := c.DSN(defaultPath)
+	if err != nil {
+		return nil, err
+	}
+	var t *Target
+	if c.Database.Driver == "sqlite3" {
+		if t, err = SQLiteTarget(storage, dsn); err != nil {
+			return nil, err
+		}
+	} else {
+		if storage != "" && storage != StorageFile {
+			return nil, fmt.Errorf("storage %q is only supported by sqlite3", storage)
+		}
+		t = &Target{Driver: c.Database.Driver, DSN: dsn}
+	}
+	t.Debug = c.Database.DebugSQL
+	t.Fixtures = c.Bench.Fixtures
+	return t, nil
+}
+
+// SQLiteTarget 按存储模式生成 SQLite 连接，dsn 是文件路径或带参数的 file: URI，
+// memory 模式只保留其中的参数
+func SQLiteTarget(storage, dsn string) (*Target, error) {
+	t := &Target{Driver: "sqlite3", Storage: storage}
+	path, params, _ := strings.Cut(strings.TrimPrefix(dsn, "file:"), "?")
+	switch storage {
+	case StorageFile, "":
+		t.Storage = StorageFile
+		t.DSN = dsn
+	case StorageWAL:
+		t.DSN = sqliteURI(path, params, "_journal_mode=WAL")
+	case StorageMemory:
+		t.DSN = sqliteURI(fmt.Sprintf("jormbench_%d", os.Getpid()), params, "mode=memory&cache=shared")
+		keeper, err := sql.Open(t.Driver, t.DSN)
+		if err != nil {
+			return nil, err
+		}
+		if err := keeper.Ping(); err != nil {
+			keeper.Close()
+			return nil, err
+		}
+		t.keeper = keeper
+	default:
+		return nil, fmt.Errorf("unknown storage %q (want file, memory or wal)", storage)
+	}
+	return t, nil
+}
+
+func sqliteURI(path string, params ...string) string {
+	var nonEmpty []string
+	for _, p := range params {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return "file:" + path + "?" + strings.Join(nonEmpty, "&")
+}
+
+// Close 释放内存数据库
+func (t *Target) Close() error {
+	if t.keeper != nil {
+		return t.keeper.Close()
+	}
+	return nil
+}
+
+// schemas 与 create_table.sql 一致，表已存在时保留
+var schemas = map[string]string{
+	"sqlite3": `CREATE TABLE IF NOT EXISTS users (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username TEXT NOT NULL,
+    age INTEGER NOT NULL
+)`,
+	"mysql": `CREATE TABLE IF NOT EXISTS users (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    username VARCHAR(255) NOT NULL,
+    age INT NOT NULL
+)`,
+}
+
+// Seed 建表并把 users 重置为 rows 条数据，数据与 benchmark 中的 setupTestData 相同
+func (t *Target) Seed(rows int) error {
+	return t.Restore(Fixture{Rows: rows})
+}
+
+// insert 建表、清空 users 并逐行插入 f 描述的数据
+func (t *Target) insert(f Fixture) error {
+	db, err := sql.Open(t.Driver, t.DSN)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	schema, ok := schemas[t.Driver]
+	if !ok {
+		return fmt.Errorf("seed: unsupported driver %q", t.Driver)
+	}
+	if _, err := db.Exec(schema); err != nil {
+		return fmt.Errorf("create users: %w", err)
+	}
+	if t.Driver == "mysql" {
+		// TRUNCATE 同时重置自增 ID
+		if _, err := db.Exec("TRUNCATE TABLE users"); err != nil {
+			return fmt.Errorf("truncate users: %w", err)
+		}
+	} else {
+		if _, err := db.Exec("DELETE FROM users"); err != nil {
+			return fmt.Errorf("delete users: %w", err)
+		}
+		// 重置自增 ID，表还没有插入过数据时 sqlite_sequence 不存在，忽略错误
+		db.Exec("DELETE FROM sqlite_sequence WHERE name='users'")
+	}
+	return insertRows(db, f)
+}
+
+// insertRows 在一个事务中插入 f 描述的数据
+func insertRows(db *sql.DB, f Fixture) error {
+	if f.Rows == 0 {
+		return nil
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("INSERT INTO users (username, age) VALUES (?, ?)")
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("prepare insert: %w", err)
+	}
+	defer stmt.Close()
+	ages := f.ages()
+	for i := 1; i <= f.Rows; i++ {
+		if _, err := stmt.Exec(fmt.Sprintf("user_%d", i), ages(i)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("insert data: %w", err)
+		}
+	}
+	return tx.Commit()
+}
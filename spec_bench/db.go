@@ -0,0 +1,34 @@
+package spec_bench
+
+import (
+	"database/sql"
+
+	"github.com/shrek82/jorm"
+	"goapi/scenario"
+	"gorm.io/gorm"
+	"xorm.io/xorm"
+)
+
+// benchTarget 是本包 benchmark 使用的连接，见 scenario.BenchTarget
+var benchTarget = scenario.BenchTarget
+
+// DefaultDSN 返回配置生成的 DSN，配置无效时返回错误
+func DefaultDSN() (string, error) {
+	t, err := benchTarget()
+	if err != nil {
+		return "", err
+	}
+	return t.DSN, nil
+}
+
+// NewSQLDB 返回底层 *sql.DB，主要用于在 benchmark 中做 DELETE 等操作
+func NewSQLDB() (*sql.DB, error) { return scenario.BenchSQLDB() }
+
+// NewJormEngine 初始化 jorm 引擎（返回 *jorm.DB），见 scenario.OpenJorm
+func NewJormEngine() (*jorm.DB, error) { return scenario.BenchJorm() }
+
+// NewGormDB 初始化 gorm DB
+func NewGormDB() (*gorm.DB, error) { return scenario.BenchGorm() }
+
+// NewXormEngine 初始化 xorm Engine
+func NewXormEngine() (*xorm.Engine, error) { return scenario.BenchXorm() }
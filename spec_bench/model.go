@@ -0,0 +1,18 @@
+// Package spec_bench 中的 benchmark 由 scenarios.yaml 生成，修改场景后运行 go generate
+package spec_bench
+
+//go:generate go run .. genbench -spec scenarios.yaml -o scenarios_gen_test.go
+
+// User 用于三种 ORM 统一对比的模型，与 specgen.Models 中的 User 一致
+// 注意：struct tag 同时包含 jorm / gorm / xorm 的配置
+
+type User struct {
+	ID   int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	Name string `jorm:"column:username" gorm:"column:username" xorm:"'username'"`
+	Age  int    `jorm:"column:age" gorm:"column:age" xorm:"'age'"`
+}
+
+// TableName 使三种 ORM 使用同一张表
+func (User) TableName() string {
+	return "users"
+}
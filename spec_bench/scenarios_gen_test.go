@@ -0,0 +1,633 @@
+// Code generated by jormbench genbench from scenarios.yaml; DO NOT EDIT.
+
+package spec_bench
+
+import (
+	"database/sql"
+	"testing"
+
+	"goapi/sqlcount/sqlcounttest"
+)
+
+// setupTestData 建表并把 users 重置为 count 条预置数据
+func setupTestData(b *testing.B, count int) {
+	b.Helper()
+	t, err := benchTarget()
+	if err != nil {
+		b.Fatalf("load config: %v", err)
+	}
+	if err := t.Seed(count); err != nil {
+		b.Fatalf("seed users: %v", err)
+	}
+}
+
+// restore 暂停计时并恢复预置数据，变更类场景每次操作后调用，保证每次迭代面对相同的数据
+func restore(b *testing.B, count int) {
+	b.StopTimer()
+	setupTestData(b, count)
+	b.StartTimer()
+}
+
+// rawDB 以计数驱动打开 database/sql，与 ORM 走同一条统计路径
+func rawDB(b *testing.B) *sql.DB {
+	b.Helper()
+	t, err := benchTarget()
+	if err != nil {
+		b.Fatalf("load config: %v", err)
+	}
+	db, err := sql.Open(t.ORMDriver(), t.DSN)
+	if err != nil {
+		b.Fatalf("open raw db: %v", err)
+	}
+	return db
+}
+
+// affected 返回 Exec 影响的行数
+func affected(res sql.Result, err error) (int64, error) {
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// checkRows 校验每次操作返回或影响的行数
+func checkRows(b *testing.B, orm string, got, want int64) {
+	if got != want {
+		b.Fatalf("%s: got %d rows, want %d", orm, got, want)
+	}
+}
+
+// BenchmarkJormFirstByID 测试 jorm：按主键轮询查询单条
+func BenchmarkJormFirstByID(b *testing.B) {
+	setupTestData(b, 1000)
+
+	engine, err := NewJormEngine()
+	if err != nil {
+		b.Fatalf("new jorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var u User
+		err := engine.Model(&u).Where("id = ?", i%1000+1).First(&u)
+		got := int64(1)
+		if err != nil {
+			b.Fatalf("jorm first: %v", err)
+		}
+		checkRows(b, "jorm", got, 1)
+	}
+}
+
+// BenchmarkGormFirstByID 测试 gorm：按主键轮询查询单条
+func BenchmarkGormFirstByID(b *testing.B) {
+	setupTestData(b, 1000)
+
+	db, err := NewGormDB()
+	if err != nil {
+		b.Fatalf("new gorm db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("gorm db.DB(): %v", err)
+	}
+	defer sqlDB.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var u User
+		err := db.Where("id = ?", i%1000+1).First(&u).Error
+		got := int64(1)
+		if err != nil {
+			b.Fatalf("gorm first: %v", err)
+		}
+		checkRows(b, "gorm", got, 1)
+	}
+}
+
+// BenchmarkXormFirstByID 测试 xorm：按主键轮询查询单条
+func BenchmarkXormFirstByID(b *testing.B) {
+	setupTestData(b, 1000)
+
+	engine, err := NewXormEngine()
+	if err != nil {
+		b.Fatalf("new xorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var u User
+		has, err := engine.Where("id = ?", i%1000+1).Get(&u)
+		got := int64(0)
+		if has {
+			got = 1
+		}
+		if err != nil {
+			b.Fatalf("xorm first: %v", err)
+		}
+		checkRows(b, "xorm", got, 1)
+	}
+}
+
+// BenchmarkRawFirstByID 测试 raw：按主键轮询查询单条
+func BenchmarkRawFirstByID(b *testing.B) {
+	setupTestData(b, 1000)
+
+	db := rawDB(b)
+	defer db.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var u User
+		err := db.QueryRow("SELECT id, username, age FROM users WHERE id = ? LIMIT 1", i%1000+1).Scan(&u.ID, &u.Name, &u.Age)
+		got := int64(1)
+		if err != nil {
+			b.Fatalf("raw first: %v", err)
+		}
+		checkRows(b, "raw", got, 1)
+	}
+}
+
+// BenchmarkJormFindByAge 测试 jorm find WHERE age = ?，期望 167 行
+func BenchmarkJormFindByAge(b *testing.B) {
+	setupTestData(b, 5000)
+
+	engine, err := NewJormEngine()
+	if err != nil {
+		b.Fatalf("new jorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var rows []User
+		err := engine.Model(&User{}).Where("age = ?", 25).Find(&rows)
+		got := int64(len(rows))
+		if err != nil {
+			b.Fatalf("jorm find: %v", err)
+		}
+		checkRows(b, "jorm", got, 167)
+	}
+}
+
+// BenchmarkGormFindByAge 测试 gorm find WHERE age = ?，期望 167 行
+func BenchmarkGormFindByAge(b *testing.B) {
+	setupTestData(b, 5000)
+
+	db, err := NewGormDB()
+	if err != nil {
+		b.Fatalf("new gorm db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("gorm db.DB(): %v", err)
+	}
+	defer sqlDB.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var rows []User
+		err := db.Where("age = ?", 25).Find(&rows).Error
+		got := int64(len(rows))
+		if err != nil {
+			b.Fatalf("gorm find: %v", err)
+		}
+		checkRows(b, "gorm", got, 167)
+	}
+}
+
+// BenchmarkXormFindByAge 测试 xorm find WHERE age = ?，期望 167 行
+func BenchmarkXormFindByAge(b *testing.B) {
+	setupTestData(b, 5000)
+
+	engine, err := NewXormEngine()
+	if err != nil {
+		b.Fatalf("new xorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var rows []User
+		err := engine.Where("age = ?", 25).Find(&rows)
+		got := int64(len(rows))
+		if err != nil {
+			b.Fatalf("xorm find: %v", err)
+		}
+		checkRows(b, "xorm", got, 167)
+	}
+}
+
+// BenchmarkRawFindByAge 测试 raw find WHERE age = ?，期望 167 行
+func BenchmarkRawFindByAge(b *testing.B) {
+	setupTestData(b, 5000)
+
+	db := rawDB(b)
+	defer db.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var rows []User
+		res, err := db.Query("SELECT id, username, age FROM users WHERE age = ?", 25)
+		if err == nil {
+			for res.Next() {
+				var u User
+				if err = res.Scan(&u.ID, &u.Name, &u.Age); err != nil {
+					break
+				}
+				rows = append(rows, u)
+			}
+			if err == nil {
+				err = res.Err()
+			}
+			res.Close()
+		}
+		got := int64(len(rows))
+		if err != nil {
+			b.Fatalf("raw find: %v", err)
+		}
+		checkRows(b, "raw", got, 167)
+	}
+}
+
+// BenchmarkJormFindAdultsLimit 测试 jorm：范围条件加 LIMIT，只取前 100 行
+func BenchmarkJormFindAdultsLimit(b *testing.B) {
+	setupTestData(b, 5000)
+
+	engine, err := NewJormEngine()
+	if err != nil {
+		b.Fatalf("new jorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var rows []User
+		err := engine.Model(&User{}).Where("age >= ?", 30).Limit(100).Find(&rows)
+		got := int64(len(rows))
+		if err != nil {
+			b.Fatalf("jorm find: %v", err)
+		}
+		checkRows(b, "jorm", got, 100)
+	}
+}
+
+// BenchmarkGormFindAdultsLimit 测试 gorm：范围条件加 LIMIT，只取前 100 行
+func BenchmarkGormFindAdultsLimit(b *testing.B) {
+	setupTestData(b, 5000)
+
+	db, err := NewGormDB()
+	if err != nil {
+		b.Fatalf("new gorm db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("gorm db.DB(): %v", err)
+	}
+	defer sqlDB.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var rows []User
+		err := db.Where("age >= ?", 30).Limit(100).Find(&rows).Error
+		got := int64(len(rows))
+		if err != nil {
+			b.Fatalf("gorm find: %v", err)
+		}
+		checkRows(b, "gorm", got, 100)
+	}
+}
+
+// BenchmarkXormFindAdultsLimit 测试 xorm：范围条件加 LIMIT，只取前 100 行
+func BenchmarkXormFindAdultsLimit(b *testing.B) {
+	setupTestData(b, 5000)
+
+	engine, err := NewXormEngine()
+	if err != nil {
+		b.Fatalf("new xorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var rows []User
+		err := engine.Where("age >= ?", 30).Limit(100).Find(&rows)
+		got := int64(len(rows))
+		if err != nil {
+			b.Fatalf("xorm find: %v", err)
+		}
+		checkRows(b, "xorm", got, 100)
+	}
+}
+
+// BenchmarkRawFindAdultsLimit 测试 raw：范围条件加 LIMIT，只取前 100 行
+func BenchmarkRawFindAdultsLimit(b *testing.B) {
+	setupTestData(b, 5000)
+
+	db := rawDB(b)
+	defer db.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var rows []User
+		res, err := db.Query("SELECT id, username, age FROM users WHERE age >= ? LIMIT 100", 30)
+		if err == nil {
+			for res.Next() {
+				var u User
+				if err = res.Scan(&u.ID, &u.Name, &u.Age); err != nil {
+					break
+				}
+				rows = append(rows, u)
+			}
+			if err == nil {
+				err = res.Err()
+			}
+			res.Close()
+		}
+		got := int64(len(rows))
+		if err != nil {
+			b.Fatalf("raw find: %v", err)
+		}
+		checkRows(b, "raw", got, 100)
+	}
+}
+
+// BenchmarkJormCountAgeRange 测试 jorm count WHERE age BETWEEN ? AND ?，期望 1669 行
+func BenchmarkJormCountAgeRange(b *testing.B) {
+	setupTestData(b, 5000)
+
+	engine, err := NewJormEngine()
+	if err != nil {
+		b.Fatalf("new jorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		got, err := engine.Model(&User{}).Where("age BETWEEN ? AND ?", 20, 29).Count()
+		if err != nil {
+			b.Fatalf("jorm count: %v", err)
+		}
+		checkRows(b, "jorm", got, 1669)
+	}
+}
+
+// BenchmarkGormCountAgeRange 测试 gorm count WHERE age BETWEEN ? AND ?，期望 1669 行
+func BenchmarkGormCountAgeRange(b *testing.B) {
+	setupTestData(b, 5000)
+
+	db, err := NewGormDB()
+	if err != nil {
+		b.Fatalf("new gorm db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("gorm db.DB(): %v", err)
+	}
+	defer sqlDB.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var got int64
+		err := db.Model(&User{}).Where("age BETWEEN ? AND ?", 20, 29).Count(&got).Error
+		if err != nil {
+			b.Fatalf("gorm count: %v", err)
+		}
+		checkRows(b, "gorm", got, 1669)
+	}
+}
+
+// BenchmarkXormCountAgeRange 测试 xorm count WHERE age BETWEEN ? AND ?，期望 1669 行
+func BenchmarkXormCountAgeRange(b *testing.B) {
+	setupTestData(b, 5000)
+
+	engine, err := NewXormEngine()
+	if err != nil {
+		b.Fatalf("new xorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		got, err := engine.Where("age BETWEEN ? AND ?", 20, 29).Count(&User{})
+		if err != nil {
+			b.Fatalf("xorm count: %v", err)
+		}
+		checkRows(b, "xorm", got, 1669)
+	}
+}
+
+// BenchmarkRawCountAgeRange 测试 raw count WHERE age BETWEEN ? AND ?，期望 1669 行
+func BenchmarkRawCountAgeRange(b *testing.B) {
+	setupTestData(b, 5000)
+
+	db := rawDB(b)
+	defer db.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		var got int64
+		err := db.QueryRow("SELECT COUNT(*) FROM users WHERE age BETWEEN ? AND ?", 20, 29).Scan(&got)
+		if err != nil {
+			b.Fatalf("raw count: %v", err)
+		}
+		checkRows(b, "raw", got, 1669)
+	}
+}
+
+// BenchmarkJormUpdateAgeRange 测试 jorm update WHERE age BETWEEN ? AND ?，期望 165 行
+func BenchmarkJormUpdateAgeRange(b *testing.B) {
+	setupTestData(b, 1000)
+
+	engine, err := NewJormEngine()
+	if err != nil {
+		b.Fatalf("new jorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		got, err := engine.Model(&User{}).Where("age BETWEEN ? AND ?", 40, 44).Update(map[string]any{"age": 50})
+		if err != nil {
+			b.Fatalf("jorm update: %v", err)
+		}
+		checkRows(b, "jorm", got, 165)
+		restore(b, 1000)
+	}
+}
+
+// BenchmarkGormUpdateAgeRange 测试 gorm update WHERE age BETWEEN ? AND ?，期望 165 行
+func BenchmarkGormUpdateAgeRange(b *testing.B) {
+	setupTestData(b, 1000)
+
+	db, err := NewGormDB()
+	if err != nil {
+		b.Fatalf("new gorm db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("gorm db.DB(): %v", err)
+	}
+	defer sqlDB.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		res := db.Model(&User{}).Where("age BETWEEN ? AND ?", 40, 44).Updates(map[string]any{"age": 50})
+		got, err := res.RowsAffected, res.Error
+		if err != nil {
+			b.Fatalf("gorm update: %v", err)
+		}
+		checkRows(b, "gorm", got, 165)
+		restore(b, 1000)
+	}
+}
+
+// BenchmarkXormUpdateAgeRange 测试 xorm update WHERE age BETWEEN ? AND ?，期望 165 行
+func BenchmarkXormUpdateAgeRange(b *testing.B) {
+	setupTestData(b, 1000)
+
+	engine, err := NewXormEngine()
+	if err != nil {
+		b.Fatalf("new xorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		got, err := engine.Table(&User{}).Where("age BETWEEN ? AND ?", 40, 44).Update(map[string]any{"age": 50})
+		if err != nil {
+			b.Fatalf("xorm update: %v", err)
+		}
+		checkRows(b, "xorm", got, 165)
+		restore(b, 1000)
+	}
+}
+
+// BenchmarkRawUpdateAgeRange 测试 raw update WHERE age BETWEEN ? AND ?，期望 165 行
+func BenchmarkRawUpdateAgeRange(b *testing.B) {
+	setupTestData(b, 1000)
+
+	db := rawDB(b)
+	defer db.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		got, err := affected(db.Exec("UPDATE users SET age = ? WHERE age BETWEEN ? AND ?", 50, 40, 44))
+		if err != nil {
+			b.Fatalf("raw update: %v", err)
+		}
+		checkRows(b, "raw", got, 165)
+		restore(b, 1000)
+	}
+}
+
+// BenchmarkJormDeleteByAge 测试 jorm delete WHERE age = ?，期望 33 行
+func BenchmarkJormDeleteByAge(b *testing.B) {
+	setupTestData(b, 1000)
+
+	engine, err := NewJormEngine()
+	if err != nil {
+		b.Fatalf("new jorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		got, err := engine.Model(&User{}).Where("age = ?", 33).Delete()
+		if err != nil {
+			b.Fatalf("jorm delete: %v", err)
+		}
+		checkRows(b, "jorm", got, 33)
+		restore(b, 1000)
+	}
+}
+
+// BenchmarkGormDeleteByAge 测试 gorm delete WHERE age = ?，期望 33 行
+func BenchmarkGormDeleteByAge(b *testing.B) {
+	setupTestData(b, 1000)
+
+	db, err := NewGormDB()
+	if err != nil {
+		b.Fatalf("new gorm db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("gorm db.DB(): %v", err)
+	}
+	defer sqlDB.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		res := db.Where("age = ?", 33).Delete(&User{})
+		got, err := res.RowsAffected, res.Error
+		if err != nil {
+			b.Fatalf("gorm delete: %v", err)
+		}
+		checkRows(b, "gorm", got, 33)
+		restore(b, 1000)
+	}
+}
+
+// BenchmarkXormDeleteByAge 测试 xorm delete WHERE age = ?，期望 33 行
+func BenchmarkXormDeleteByAge(b *testing.B) {
+	setupTestData(b, 1000)
+
+	engine, err := NewXormEngine()
+	if err != nil {
+		b.Fatalf("new xorm engine: %v", err)
+	}
+	defer engine.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		got, err := engine.Where("age = ?", 33).Delete(&User{})
+		if err != nil {
+			b.Fatalf("xorm delete: %v", err)
+		}
+		checkRows(b, "xorm", got, 33)
+		restore(b, 1000)
+	}
+}
+
+// BenchmarkRawDeleteByAge 测试 raw delete WHERE age = ?，期望 33 行
+func BenchmarkRawDeleteByAge(b *testing.B) {
+	setupTestData(b, 1000)
+
+	db := rawDB(b)
+	defer db.Close()
+
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		got, err := affected(db.Exec("DELETE FROM users WHERE age = ?", 33))
+		if err != nil {
+			b.Fatalf("raw delete: %v", err)
+		}
+		checkRows(b, "raw", got, 33)
+		restore(b, 1000)
+	}
+}
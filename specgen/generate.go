@@ -0,0 +1,243 @@
+package specgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// ORMs 是生成的 Benchmark 名称前缀，顺序与其他 benchmark 包一致，raw 是 database/sql 基线
+var ORMs = []string{"Jorm", "Gorm", "Xorm", "Raw"}
+
+// Generate 把 f 生成为 benchmark 源码，pkg 非空时覆盖 f.Package，source 写入文件头部
+func Generate(w io.Writer, f *File, pkg, source string) error {
+	if pkg == "" {
+		pkg = f.Package
+	}
+	if pkg == "" {
+		return fmt.Errorf("specgen: package name is required")
+	}
+	if err := f.Validate(); err != nil {
+		return err
+	}
+	var g gen
+	g.printf("// Code generated by jormbench genbench from %s; DO NOT EDIT.\n\n", source)
+	g.printf("package %s\n\n", pkg)
+	g.printf("import (\n\t\"database/sql\"\n\t\"testing\"\n\n\t\"goapi/sqlcount/sqlcounttest\"\n)\n\n")
+	g.buf.WriteString(helpers)
+	for _, s := range f.Scenarios {
+		for _, orm := range ORMs {
+			g.benchmark(s, orm)
+		}
+	}
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("specgen: format generated code: %w", err)
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// helpers 是生成文件共用的函数，包中需要有 benchTarget 与 NewJormEngine 等 (见各 benchmark 包的 db.go)
+const helpers = `// setupTestData 建表并把 users 重置为 count 条预置数据
+func setupTestData(b *testing.B, count int) {
+	b.Helper()
+	t, err := benchTarget()
+	if err != nil {
+		b.Fatalf("load config: %v", err)
+	}
+	if err := t.Seed(count); err != nil {
+		b.Fatalf("seed users: %v", err)
+	}
+}
+
+// restore 暂停计时并恢复预置数据，变更类场景每次操作后调用，保证每次迭代面对相同的数据
+func restore(b *testing.B, count int) {
+	b.StopTimer()
+	setupTestData(b, count)
+	b.StartTimer()
+}
+
+// rawDB 以计数驱动打开 database/sql，与 ORM 走同一条统计路径
+func rawDB(b *testing.B) *sql.DB {
+	b.Helper()
+	t, err := benchTarget()
+	if err != nil {
+		b.Fatalf("load config: %v", err)
+	}
+	db, err := sql.Open(t.ORMDriver(), t.DSN)
+	if err != nil {
+		b.Fatalf("open raw db: %v", err)
+	}
+	return db
+}
+
+// affected 返回 Exec 影响的行数
+func affected(res sql.Result, err error) (int64, error) {
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// checkRows 校验每次操作返回或影响的行数
+func checkRows(b *testing.B, orm string, got, want int64) {
+	if got != want {
+		b.Fatalf("%s: got %d rows, want %d", orm, got, want)
+	}
+}
+
+`
+
+type gen struct {
+	buf bytes.Buffer
+}
+
+func (g *gen) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// call 是 Where 及其参数，例如 "age > ?", 30
+func (s Scenario) call() string {
+	parts := []string{fmt.Sprintf("%q", s.Where)}
+	for _, a := range s.Args {
+		lit, _ := literal(a)
+		parts = append(parts, lit)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// args 是原生 SQL 的参数列表，前面带逗号
+func (s Scenario) args() string {
+	var out string
+	for _, a := range s.Args {
+		lit, _ := literal(a)
+		out += ", " + lit
+	}
+	return out
+}
+
+// setMap 是 update 的 map[string]any 字面量，按列名排序
+func (s Scenario) setMap() string {
+	var parts []string
+	for _, col := range slices.Sorted(maps.Keys(s.Set)) {
+		lit, _ := literal(s.Set[col])
+		parts = append(parts, fmt.Sprintf("%q: %s", col, lit))
+	}
+	return "map[string]any{" + strings.Join(parts, ", ") + "}"
+}
+
+func (s Scenario) doc(orm string) string {
+	if s.Doc != "" {
+		return fmt.Sprintf("测试 %s：%s", strings.ToLower(orm), s.Doc)
+	}
+	return fmt.Sprintf("测试 %s %s WHERE %s，期望 %d 行", strings.ToLower(orm), s.Op, s.Where, s.expect())
+}
+
+func (g *gen) benchmark(s Scenario, orm string) {
+	m := Models[s.Model]
+	name := strings.ToLower(orm)
+	g.printf("// Benchmark%s%s %s\n", orm, s.Name, s.doc(orm))
+	g.printf("func Benchmark%s%s(b *testing.B) {\n", orm, s.Name)
+	g.printf("\tsetupTestData(b, %d)\n\n", s.Rows)
+	switch orm {
+	case "Jorm":
+		g.printf("\tengine, err := NewJormEngine()\n\tif err != nil {\n\t\tb.Fatalf(\"new jorm engine: %%v\", err)\n\t}\n\tdefer engine.Close()\n")
+	case "Gorm":
+		g.printf("\tdb, err := NewGormDB()\n\tif err != nil {\n\t\tb.Fatalf(\"new gorm db: %%v\", err)\n\t}\n")
+		g.printf("\tsqlDB, err := db.DB()\n\tif err != nil {\n\t\tb.Fatalf(\"gorm db.DB(): %%v\", err)\n\t}\n\tdefer sqlDB.Close()\n")
+	case "Xorm":
+		g.printf("\tengine, err := NewXormEngine()\n\tif err != nil {\n\t\tb.Fatalf(\"new xorm engine: %%v\", err)\n\t}\n\tdefer engine.Close()\n")
+	case "Raw":
+		g.printf("\tdb := rawDB(b)\n\tdefer db.Close()\n")
+	}
+	g.printf("\n\tb.ResetTimer()\n\tdefer sqlcounttest.Measure(b)()\n")
+	g.printf("\tfor i := 0; i < b.N; i++ {\n")
+	g.op(s, m, orm)
+	g.printf("\t\tif err != nil {\n\t\t\tb.Fatalf(\"%s %s: %%v\", err)\n\t\t}\n", name, s.Op)
+	g.printf("\t\tcheckRows(b, %q, got, %d)\n", name, s.expect())
+	if s.mutates() {
+		g.printf("\t\trestore(b, %d)\n", s.Rows)
+	}
+	g.printf("\t}\n}\n\n")
+}
+
+// op 生成一次操作，结果写入 got (int64) 与 err
+func (g *gen) op(s Scenario, m Model, orm string) {
+	where, call := s.Where, s.call()
+	limit := ""
+	if s.Limit > 0 {
+		limit = fmt.Sprintf(".Limit(%d)", s.Limit)
+	}
+	cols := strings.Join(m.Columns, ", ")
+	scan := make([]string, len(m.Fields))
+	for i, f := range m.Fields {
+		scan[i] = "&u." + f
+	}
+
+	switch orm + "/" + s.Op {
+	case "Jorm/first":
+		g.printf("\t\tvar u %s\n\t\terr := engine.Model(&u).Where(%s).First(&u)\n\t\tgot := int64(1)\n", s.Model, call)
+	case "Gorm/first":
+		g.printf("\t\tvar u %s\n\t\terr := db.Where(%s).First(&u).Error\n\t\tgot := int64(1)\n", s.Model, call)
+	case "Xorm/first":
+		g.printf("\t\tvar u %s\n\t\thas, err := engine.Where(%s).Get(&u)\n\t\tgot := int64(0)\n\t\tif has {\n\t\t\tgot = 1\n\t\t}\n", s.Model, call)
+	case "Raw/first":
+		g.printf("\t\tvar u %s\n\t\terr := db.QueryRow(%q%s).Scan(%s)\n\t\tgot := int64(1)\n",
+			s.Model, fmt.Sprintf("SELECT %s FROM %s WHERE %s LIMIT 1", cols, m.Table, where), s.args(), strings.Join(scan, ", "))
+
+	case "Jorm/find":
+		g.printf("\t\tvar rows []%s\n\t\terr := engine.Model(&%s{}).Where(%s)%s.Find(&rows)\n\t\tgot := int64(len(rows))\n", s.Model, s.Model, call, limit)
+	case "Gorm/find":
+		g.printf("\t\tvar rows []%s\n\t\terr := db.Where(%s)%s.Find(&rows).Error\n\t\tgot := int64(len(rows))\n", s.Model, call, limit)
+	case "Xorm/find":
+		g.printf("\t\tvar rows []%s\n\t\terr := engine.Where(%s)%s.Find(&rows)\n\t\tgot := int64(len(rows))\n", s.Model, call, limit)
+	case "Raw/find":
+		query := fmt.Sprintf("SELECT %s FROM %s WHERE %s", cols, m.Table, where)
+		if s.Limit > 0 {
+			query += fmt.Sprintf(" LIMIT %d", s.Limit)
+		}
+		g.printf("\t\tvar rows []%s\n\t\tres, err := db.Query(%q%s)\n", s.Model, query, s.args())
+		g.printf("\t\tif err == nil {\n\t\t\tfor res.Next() {\n\t\t\t\tvar u %s\n", s.Model)
+		g.printf("\t\t\t\tif err = res.Scan(%s); err != nil {\n\t\t\t\t\tbreak\n\t\t\t\t}\n\t\t\t\trows = append(rows, u)\n\t\t\t}\n", strings.Join(scan, ", "))
+		g.printf("\t\t\tif err == nil {\n\t\t\t\terr = res.Err()\n\t\t\t}\n\t\t\tres.Close()\n\t\t}\n\t\tgot := int64(len(rows))\n")
+
+	case "Jorm/count":
+		g.printf("\t\tgot, err := engine.Model(&%s{}).Where(%s).Count()\n", s.Model, call)
+	case "Gorm/count":
+		g.printf("\t\tvar got int64\n\t\terr := db.Model(&%s{}).Where(%s).Count(&got).Error\n", s.Model, call)
+	case "Xorm/count":
+		g.printf("\t\tgot, err := engine.Where(%s).Count(&%s{})\n", call, s.Model)
+	case "Raw/count":
+		g.printf("\t\tvar got int64\n\t\terr := db.QueryRow(%q%s).Scan(&got)\n", fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", m.Table, where), s.args())
+
+	case "Jorm/update":
+		g.printf("\t\tgot, err := engine.Model(&%s{}).Where(%s).Update(%s)\n", s.Model, call, s.setMap())
+	case "Gorm/update":
+		g.printf("\t\tres := db.Model(&%s{}).Where(%s).Updates(%s)\n\t\tgot, err := res.RowsAffected, res.Error\n", s.Model, call, s.setMap())
+	case "Xorm/update":
+		g.printf("\t\tgot, err := engine.Table(&%s{}).Where(%s).Update(%s)\n", s.Model, call, s.setMap())
+	case "Raw/update":
+		var sets, vals []string
+		for _, col := range slices.Sorted(maps.Keys(s.Set)) {
+			sets = append(sets, col+" = ?")
+			lit, _ := literal(s.Set[col])
+			vals = append(vals, lit)
+		}
+		query := fmt.Sprintf("UPDATE %s SET %s WHERE %s", m.Table, strings.Join(sets, ", "), where)
+		g.printf("\t\tgot, err := affected(db.Exec(%q, %s%s))\n", query, strings.Join(vals, ", "), s.args())
+
+	case "Jorm/delete":
+		g.printf("\t\tgot, err := engine.Model(&%s{}).Where(%s).Delete()\n", s.Model, call)
+	case "Gorm/delete":
+		g.printf("\t\tres := db.Where(%s).Delete(&%s{})\n\t\tgot, err := res.RowsAffected, res.Error\n", call, s.Model)
+	case "Xorm/delete":
+		g.printf("\t\tgot, err := engine.Where(%s).Delete(&%s{})\n", call, s.Model)
+	case "Raw/delete":
+		g.printf("\t\tgot, err := affected(db.Exec(%q%s))\n", fmt.Sprintf("DELETE FROM %s WHERE %s", m.Table, where), s.args())
+	}
+}
@@ -0,0 +1,200 @@
+// Package specgen 把声明式的场景描述 (YAML 或 JSON) 生成为 go test benchmark：
+// 每个场景为 jorm / gorm / xorm 与 database/sql 基线各生成一个 Benchmark，
+// 命名、预置数据、计时与结果校验 (返回或影响的行数) 都由生成器统一处理
+package specgen
+
+import (
+	"fmt"
+	"go/parser"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 支持的操作
+const (
+	OpFirst  = "first"  // 按条件查询第一条，期望 1 行
+	OpFind   = "find"   // 按条件查询列表，校验返回的行数
+	OpCount  = "count"  // COUNT(*)，校验计数
+	OpUpdate = "update" // 按条件更新 set 中的列，校验影响行数，每次操作后在计时之外恢复数据
+	OpDelete = "delete" // 按条件删除，校验影响行数，每次操作后在计时之外恢复数据
+)
+
+// Ops 是全部操作
+var Ops = []string{OpFirst, OpFind, OpCount, OpUpdate, OpDelete}
+
+// File 是场景描述文件
+type File struct {
+	// Package 是生成文件的包名，为空时由调用方决定
+	Package   string     `yaml:"package"`
+	Scenarios []Scenario `yaml:"scenarios"`
+}
+
+// Scenario 描述一个场景
+type Scenario struct {
+	// Name 是 Benchmark 名称中 ORM 之后的部分，例如 FindAdults -> BenchmarkJormFindAdults
+	Name string `yaml:"name"`
+	// Doc 写入生成的注释，为空时根据操作与条件生成
+	Doc string `yaml:"doc"`
+	// Model 是模型名，见 Models
+	Model string `yaml:"model"`
+	// Rows 是预置的数据量，数据与其他 benchmark 包相同 (user_i, 20+i%30)
+	Rows int    `yaml:"rows"`
+	Op   string `yaml:"op"`
+	// Where 是带 ? 占位符的条件，作用于全部行时写 1=1
+	Where string `yaml:"where"`
+	// Args 是占位符的值；以 = 开头的字符串是 Go 表达式，可以使用迭代序号 i，例如 "= i%1000 + 1"
+	Args []any `yaml:"args"`
+	// Limit 只用于 find
+	Limit int `yaml:"limit"`
+	// Set 是 update 要写入的列与值
+	Set map[string]any `yaml:"set"`
+	// Expect 是每次操作应返回或影响的行数，first 固定为 1
+	Expect *int64 `yaml:"expect"`
+}
+
+// Model 描述生成代码可以使用的模型，字段与列一一对应
+type Model struct {
+	Table   string
+	Columns []string
+	Fields  []string
+}
+
+// Models 是已知的模型，与各 benchmark 包中的 User 一致
+var Models = map[string]Model{
+	"User": {Table: "users", Columns: []string{"id", "username", "age"}, Fields: []string{"ID", "Name", "Age"}},
+}
+
+var namePattern = regexp.MustCompile(`^[A-Z][A-Za-z0-9_]*$`)
+
+// Parse 读取 YAML 或 JSON (JSON 是 YAML 的子集) 并校验每个场景
+func Parse(r io.Reader) (*File, error) {
+	var f File
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("specgen: %w", err)
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// Validate 检查场景描述，错误信息带场景名
+func (f *File) Validate() error {
+	if len(f.Scenarios) == 0 {
+		return fmt.Errorf("specgen: no scenarios")
+	}
+	seen := map[string]bool{}
+	for i := range f.Scenarios {
+		s := &f.Scenarios[i]
+		if err := s.validate(); err != nil {
+			name := s.Name
+			if name == "" {
+				name = fmt.Sprintf("#%d", i+1)
+			}
+			return fmt.Errorf("specgen: scenario %s: %w", name, err)
+		}
+		if seen[s.Name] {
+			return fmt.Errorf("specgen: duplicate scenario %s", s.Name)
+		}
+		seen[s.Name] = true
+	}
+	return nil
+}
+
+func (s *Scenario) validate() error {
+	if !namePattern.MatchString(s.Name) {
+		return fmt.Errorf("name must be an exported Go identifier")
+	}
+	if s.Model == "" {
+		s.Model = "User"
+	}
+	m, ok := Models[s.Model]
+	if !ok {
+		return fmt.Errorf("unknown model %q", s.Model)
+	}
+	if !slices.Contains(Ops, s.Op) {
+		return fmt.Errorf("unknown op %q (want %s)", s.Op, strings.Join(Ops, ", "))
+	}
+	if s.Rows < 0 {
+		return fmt.Errorf("rows must not be negative")
+	}
+	if strings.TrimSpace(s.Where) == "" {
+		return fmt.Errorf("where is required (use 1=1 for all rows)")
+	}
+	if n := strings.Count(s.Where, "?"); n != len(s.Args) {
+		return fmt.Errorf("where has %d placeholders but %d args", n, len(s.Args))
+	}
+	for _, a := range s.Args {
+		if _, err := literal(a); err != nil {
+			return err
+		}
+	}
+	if s.Limit < 0 || s.Limit > 0 && s.Op != OpFind {
+		return fmt.Errorf("limit is only valid for find")
+	}
+	if (len(s.Set) > 0) != (s.Op == OpUpdate) {
+		return fmt.Errorf("set is required for update and only valid there")
+	}
+	for col, v := range s.Set {
+		if !slices.Contains(m.Columns, col) || col == m.Columns[0] {
+			return fmt.Errorf("cannot set column %q", col)
+		}
+		if _, err := literal(v); err != nil {
+			return err
+		}
+	}
+	switch {
+	case s.Op == OpFirst && s.Expect != nil && *s.Expect != 1:
+		return fmt.Errorf("first always expects 1 row")
+	case s.Op != OpFirst && s.Expect == nil:
+		return fmt.Errorf("expect is required for %s", s.Op)
+	case s.Expect != nil && *s.Expect < 0:
+		return fmt.Errorf("expect must not be negative")
+	}
+	return nil
+}
+
+// literal 把 YAML 中的值写成 Go 源码，以 = 开头的字符串原样作为表达式
+func literal(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		if expr, ok := strings.CutPrefix(v, "="); ok {
+			expr = strings.TrimSpace(expr)
+			if _, err := parser.ParseExpr(expr); err != nil {
+				return "", fmt.Errorf("invalid expression %q: %w", expr, err)
+			}
+			return expr, nil
+		}
+		return fmt.Sprintf("%q", v), nil
+	case float64:
+		// 保留小数点，否则 2.0 会变成 int 常量
+		lit := strconv.FormatFloat(v, 'g', -1, 64)
+		if !strings.ContainsAny(lit, ".eEn") {
+			lit += ".0"
+		}
+		return lit, nil
+	case int, int64, uint64, bool:
+		return fmt.Sprint(v), nil
+	}
+	return "", fmt.Errorf("unsupported value %v (%T)", v, v)
+}
+
+// expect 返回期望的行数
+func (s Scenario) expect() int64 {
+	if s.Expect == nil {
+		return 1
+	}
+	return *s.Expect
+}
+
+// mutates 报告操作是否修改数据
+func (s Scenario) mutates() bool {
+	return s.Op == OpUpdate || s.Op == OpDelete
+}
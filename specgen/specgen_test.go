@@ -0,0 +1,93 @@
+package specgen
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+const spec = `
+package: demo
+scenarios:
+  - name: FirstByID
+    rows: 100
+    op: first
+    where: id = ?
+    args: ["= i%100 + 1"]
+  - name: UpdateAge
+    rows: 100
+    op: update
+    where: age BETWEEN ? AND ?
+    args: [20, 21]
+    set: {age: 30, username: x}
+    expect: 7
+`
+
+func TestGenerate(t *testing.T) {
+	f, err := Parse(strings.NewReader(spec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := Generate(&buf, f, "", "spec.yaml"); err != nil {
+		t.Fatal(err)
+	}
+	src := buf.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), "gen_test.go", src, 0); err != nil {
+		t.Fatalf("generated code does not parse: %v", err)
+	}
+	for _, want := range []string{
+		"package demo",
+		"func BenchmarkJormFirstByID(b *testing.B)",
+		"func BenchmarkRawUpdateAge(b *testing.B)",
+		`Where("id = ?", i%100+1)`,
+		`map[string]any{"age": 30, "username": "x"}`,
+		`"UPDATE users SET age = ?, username = ? WHERE age BETWEEN ? AND ?", 30, "x", 20, 21`,
+		"restore(b, 100)",
+		`checkRows(b, "gorm", got, 7)`,
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("missing %q", want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		spec, err string
+	}{
+		{`scenarios: [{name: lower, op: count, where: "1=1", expect: 1}]`, "exported Go identifier"},
+		{`scenarios: [{name: A, op: upsert, where: "1=1"}]`, "unknown op"},
+		{`scenarios: [{name: A, op: count, where: "id = ?", expect: 1}]`, "1 placeholders but 0 args"},
+		{`scenarios: [{name: A, op: count, where: "1=1", limit: 3, expect: 1}]`, "limit is only valid for find"},
+		{`scenarios: [{name: A, op: update, where: "1=1", expect: 1}]`, "set is required"},
+		{`scenarios: [{name: A, op: update, where: "1=1", set: {id: 1}, expect: 1}]`, `cannot set column "id"`},
+		{`scenarios: [{name: A, op: find, where: "1=1"}]`, "expect is required"},
+		{`scenarios: [{name: A, op: first, where: "id = ?", args: ["= i +"]}]`, "invalid expression"},
+		{`scenarios: [{name: A, op: count, where: "1=1", expect: 1, rowz: 3}]`, "rowz"},
+		{`scenarios: [{name: A, op: count, where: "1=1", expect: 1}, {name: A, op: count, where: "1=1", expect: 1}]`, "duplicate"},
+	} {
+		_, err := Parse(strings.NewReader(tc.spec))
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: err = %v, want %q", tc.spec, err, tc.err)
+		}
+	}
+}
+
+func TestLiteral(t *testing.T) {
+	for v, want := range map[any]string{
+		"abc":     `"abc"`,
+		"= i % 3": "i % 3",
+		2.0:       "2.0",
+		1.5:       "1.5",
+		7:         "7",
+		true:      "true",
+	} {
+		got, err := literal(v)
+		if err != nil || got != want {
+			t.Errorf("literal(%v) = %q, %v; want %q", v, got, err, want)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+// Package sqlcount 提供一个包装 database/sql 驱动的计数驱动，
+// 统计 ORM 实际发出的 Exec / Query / Prepare / Begin / Commit 次数和 SQL 文本字节数，
+// 用来发现 INSERT 之后多余的 SELECT、隐式事务、每次调用都重新 Prepare 之类的额外往返
+package sqlcount
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"sync/atomic"
+)
+
+// Counts 是某一时刻的计数快照
+type Counts struct {
+	Exec     int64
+	Query    int64
+	Prepare  int64
+	Begin    int64
+	Commit   int64
+	Rollback int64
+	// SQLBytes 是经过驱动的 SQL 文本总字节数 (Exec / Query / Prepare)
+	SQLBytes int64
+}
+
+// Sub 返回 c - o，用于计算一段时间内的增量
+func (c Counts) Sub(o Counts) Counts {
+	return Counts{
+		Exec:     c.Exec - o.Exec,
+		Query:    c.Query - o.Query,
+		Prepare:  c.Prepare - o.Prepare,
+		Begin:    c.Begin - o.Begin,
+		Commit:   c.Commit - o.Commit,
+		Rollback: c.Rollback - o.Rollback,
+		SQLBytes: c.SQLBytes - o.SQLBytes,
+	}
+}
+
+// Queries 返回 Exec 与 Query 之和，即实际的语句往返次数
+func (c Counts) Queries() int64 {
+	return c.Exec + c.Query
+}
+
+// Counter 以原子操作累计计数，可被多个连接并发更新
+type Counter struct {
+	exec, query, prepare, begin, commit, rollback, sqlBytes atomic.Int64
+}
+
+// Snapshot 返回当前计数
+func (c *Counter) Snapshot() Counts {
+	return Counts{
+		Exec:     c.exec.Load(),
+		Query:    c.query.Load(),
+		Prepare:  c.prepare.Load(),
+		Begin:    c.begin.Load(),
+		Commit:   c.commit.Load(),
+		Rollback: c.rollback.Load(),
+		SQLBytes: c.sqlBytes.Load(),
+	}
+}
+
+// Driver 包装另一个 driver.Driver，所有连接、语句、事务上的调用都会计入 Counter
+type Driver struct {
+	Parent  driver.Driver
+	Counter *Counter
+}
+
+// Open 实现 driver.Driver
+func (d *Driver) Open(dsn string) (driver.Conn, error) {
+	c, err := d.Parent.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{Conn: c, counter: d.Counter}, nil
+}
+
+type conn struct {
+	driver.Conn
+	counter *Counter
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *conn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	var (
+		s   driver.Stmt
+		err error
+	)
+	if pc, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		s, err = pc.PrepareContext(ctx, query)
+	} else {
+		s, err = c.Conn.Prepare(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.counter.prepare.Add(1)
+	c.counter.sqlBytes.Add(int64(len(query)))
+	return &stmt{Stmt: s, counter: c.counter}, nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	var (
+		t   driver.Tx
+		err error
+	)
+	if bc, ok := c.Conn.(driver.ConnBeginTx); ok {
+		t, err = bc.BeginTx(ctx, opts)
+	} else {
+		t, err = c.Conn.Begin()
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.counter.begin.Add(1)
+	return &tx{Tx: t, counter: c.counter}, nil
+}
+
+// ExecContext 只在底层驱动真正执行时计数；返回 driver.ErrSkip 时
+// database/sql 会退回 Prepare + Exec，由 stmt 计数，避免重复统计
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	ec, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	res, err := ec.ExecContext(ctx, query, args)
+	if !errors.Is(err, driver.ErrSkip) {
+		c.counter.exec.Add(1)
+		c.counter.sqlBytes.Add(int64(len(query)))
+	}
+	return res, err
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	qc, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	rows, err := qc.QueryContext(ctx, query, args)
+	if !errors.Is(err, driver.ErrSkip) {
+		c.counter.query.Add(1)
+		c.counter.sqlBytes.Add(int64(len(query)))
+	}
+	return rows, err
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	if p, ok := c.Conn.(driver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	if nc, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return nc.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+func (c *conn) ResetSession(ctx context.Context) error {
+	if r, ok := c.Conn.(driver.SessionResetter); ok {
+		return r.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *conn) IsValid() bool {
+	if v, ok := c.Conn.(driver.Validator); ok {
+		return v.IsValid()
+	}
+	return true
+}
+
+type stmt struct {
+	driver.Stmt
+	counter *Counter
+}
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.counter.exec.Add(1)
+	return s.Stmt.Exec(args)
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.counter.query.Add(1)
+	return s.Stmt.Query(args)
+}
+
+func (s *stmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	s.counter.exec.Add(1)
+	if ec, ok := s.Stmt.(driver.StmtExecContext); ok {
+		return ec.ExecContext(ctx, args)
+	}
+	values, err := namedToValues(args)
+	if err != nil {
+		return nil, err
+	}
+	return s.Stmt.Exec(values)
+}
+
+func (s *stmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	s.counter.query.Add(1)
+	if qc, ok := s.Stmt.(driver.StmtQueryContext); ok {
+		return qc.QueryContext(ctx, args)
+	}
+	values, err := namedToValues(args)
+	if err != nil {
+		return nil, err
+	}
+	return s.Stmt.Query(values)
+}
+
+func (s *stmt) CheckNamedValue(nv *driver.NamedValue) error {
+	if nc, ok := s.Stmt.(driver.NamedValueChecker); ok {
+		return nc.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+func namedToValues(args []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(args))
+	for i, a := range args {
+		if a.Name != "" {
+			return nil, errors.New("sqlcount: driver does not support named parameters")
+		}
+		values[i] = a.Value
+	}
+	return values, nil
+}
+
+type tx struct {
+	driver.Tx
+	counter *Counter
+}
+
+func (t *tx) Commit() error {
+	t.counter.commit.Add(1)
+	return t.Tx.Commit()
+}
+
+func (t *tx) Rollback() error {
+	t.counter.rollback.Add(1)
+	return t.Tx.Rollback()
+}
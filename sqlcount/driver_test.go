@@ -0,0 +1,60 @@
+package sqlcount
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+func TestDriverCounts(t *testing.T) {
+	counter := new(Counter)
+	sql.Register("sqlite3_counted_test", &Driver{Parent: &sqlite3.SQLiteDriver{}, Counter: counter})
+	db, err := sql.Open("sqlite3_counted_test", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec("CREATE TABLE users (id INTEGER PRIMARY KEY, username TEXT)"); err != nil {
+		t.Fatal(err)
+	}
+	start := counter.Snapshot()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt, err := tx.Prepare("INSERT INTO users (username) VALUES (?)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := stmt.Exec("user"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	stmt.Close()
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	var n int
+	if err := db.QueryRow("SELECT COUNT(*) FROM users").Scan(&n); err != nil || n != 3 {
+		t.Fatalf("count = %d, err = %v", n, err)
+	}
+
+	got := counter.Snapshot().Sub(start)
+	want := Counts{Exec: 3, Query: 1, Prepare: 1, Begin: 1, Commit: 1}
+	want.SQLBytes = got.SQLBytes
+	if got != want {
+		t.Errorf("counts = %+v, want %+v", got, want)
+	}
+	if got.Queries() != 4 {
+		t.Errorf("queries = %d", got.Queries())
+	}
+	if wantBytes := int64(len("INSERT INTO users (username) VALUES (?)") + len("SELECT COUNT(*) FROM users")); got.SQLBytes != wantBytes {
+		t.Errorf("sql bytes = %d, want %d", got.SQLBytes, wantBytes)
+	}
+}
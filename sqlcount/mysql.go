@@ -0,0 +1,20 @@
+package sqlcount
+
+import (
+	"sync"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQLDriver 是计数版 MySQL 驱动的注册名
+const MySQLDriver = "mysql_counted"
+
+var registerMySQLOnce sync.Once
+
+// RegisterMySQL 注册计数版 MySQL 驱动，并让 jorm 与 xorm 把它识别为 mysql 方言，可重复调用
+func RegisterMySQL() string {
+	registerMySQLOnce.Do(func() {
+		register(MySQLDriver, "mysql", &mysql.MySQLDriver{})
+	})
+	return MySQLDriver
+}
@@ -0,0 +1,28 @@
+// Package sqlcounttest 把 sqlcount 的计数上报为 benchmark 指标，只在 _test.go 中使用，
+// 使 jormbench 命令不必链接 testing 包
+package sqlcounttest
+
+import (
+	"testing"
+
+	"goapi/sqlcount"
+)
+
+// Measure 记录计数器的当前值，返回的函数在计时循环结束后调用，
+// 把增量按 b.N 平均后通过 b.ReportMetric 上报为 queries/op、prepares/op、tx/op 和 sql-B/op：
+//
+//	b.ResetTimer()
+//	defer sqlcounttest.Measure(b)()
+func Measure(b *testing.B) func() {
+	start := sqlcount.Default.Snapshot()
+	return func() {
+		ReportMetrics(b, sqlcount.Default.Snapshot().Sub(start))
+	}
+}
+
+// ReportMetrics 把一段计时区间内的计数按 b.N 平均后上报
+func ReportMetrics(b *testing.B, c sqlcount.Counts) {
+	for unit, v := range sqlcount.Metrics(c, b.N) {
+		b.ReportMetric(v, unit)
+	}
+}
@@ -0,0 +1,63 @@
+package sqlcount
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"sync"
+
+	"github.com/mattn/go-sqlite3"
+	jormdialect "github.com/shrek82/jorm/dialect"
+	"xorm.io/xorm/dialects"
+)
+
+// SQLiteDriver 是计数版 SQLite 驱动的注册名，jorm.Open / gorm sqlite.Config.DriverName /
+// xorm.NewEngine 使用该名称即可在不改动 ORM 代码的情况下接入计数
+const SQLiteDriver = "sqlite3_counted"
+
+// Default 是所有计数版驱动 (SQLite、MySQL) 共用的计数器，sqlcounttest.Measure 读取的就是它
+var Default = new(Counter)
+
+var registerSQLiteOnce sync.Once
+
+// RegisterSQLite 注册计数版 SQLite 驱动，并让 jorm 与 xorm 把它识别为 sqlite3 方言，可重复调用
+func RegisterSQLite() string {
+	registerSQLiteOnce.Do(func() {
+		register(SQLiteDriver, "sqlite3", &sqlite3.SQLiteDriver{})
+	})
+	return SQLiteDriver
+}
+
+// Register 注册 driverName 对应的计数版驱动并返回其注册名，目前支持 sqlite3 与 mysql
+func Register(driverName string) (string, error) {
+	switch driverName {
+	case "sqlite3":
+		return RegisterSQLite(), nil
+	case "mysql":
+		return RegisterMySQL(), nil
+	}
+	return "", fmt.Errorf("sqlcount: unsupported driver %q", driverName)
+}
+
+// register 用 Default 计数器包装 parent，并让 jorm 与 xorm 使用 dialect 对应的方言
+func register(name, dialect string, parent driver.Driver) {
+	sql.Register(name, &Driver{Parent: parent, Counter: Default})
+	if d, ok := jormdialect.Get(dialect); ok {
+		jormdialect.Register(name, d)
+	}
+	dialects.RegisterDriver(name, dialects.QueryDriver(dialect))
+}
+
+// Metrics 把计数按 n 次操作平均，返回以单位为 key 的指标，n 为 0 时返回 nil
+func Metrics(c Counts, n int) map[string]float64 {
+	if n == 0 {
+		return nil
+	}
+	ops := float64(n)
+	return map[string]float64{
+		"queries/op":  float64(c.Queries()) / ops,
+		"prepares/op": float64(c.Prepare) / ops,
+		"tx/op":       float64(c.Begin) / ops,
+		"sql-B/op":    float64(c.SQLBytes) / ops,
+	}
+}
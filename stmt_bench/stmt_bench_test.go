@@ -0,0 +1,268 @@
+package stmt_bench
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"goapi/sqlcount/sqlcounttest"
+	"gorm.io/gorm"
+)
+
+// 每个子 benchmark 反复执行同一条 SQL，stmt=off 为 ORM 默认行为，stmt=on 开启该 ORM 的语句缓存：
+// gorm 使用 PrepareStmt，xorm 在长生命周期的 Session 上使用 Prepare()，
+// raw 是 database/sql 的直接查询与预编译语句基线。jorm 没有语句缓存选项，只有 stmt=off
+
+const rows = 1000
+
+// setupTestData 在每个 benchmark 开始前准备测试数据
+func setupTestData(b *testing.B) {
+	b.Helper()
+	t, err := benchTarget()
+	if err != nil {
+		b.Fatalf("load config: %v", err)
+	}
+	if err := t.Seed(rows); err != nil {
+		b.Fatalf("seed users: %v", err)
+	}
+}
+
+// loop 运行计时循环并上报往返次数，prepares/op 反映语句是否被复用
+func loop(b *testing.B, op func(i int) error) {
+	b.Helper()
+	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
+	for i := 0; i < b.N; i++ {
+		if err := op(i); err != nil {
+			b.Fatalf("op: %v", err)
+		}
+	}
+}
+
+func updatedUser(i int) User {
+	return User{
+		Name: fmt.Sprintf("updated_user_%d", i),
+		Age:  30 + i%20,
+	}
+}
+
+// BenchmarkJormStmtFindByID 测试 jorm 反复按 ID 查询 (没有语句缓存)
+func BenchmarkJormStmtFindByID(b *testing.B) {
+	b.Run("stmt=off", func(b *testing.B) {
+		setupTestData(b)
+		engine, err := NewJormEngine()
+		if err != nil {
+			b.Fatalf("new jorm engine: %v", err)
+		}
+		defer engine.Close()
+		loop(b, func(i int) error {
+			var user User
+			return engine.Model(&user).Where("id = ?", int64(i%rows+1)).First(&user)
+		})
+	})
+}
+
+// BenchmarkJormStmtUpdateByID 测试 jorm 反复按 ID 更新 (没有语句缓存)
+func BenchmarkJormStmtUpdateByID(b *testing.B) {
+	b.Run("stmt=off", func(b *testing.B) {
+		setupTestData(b)
+		engine, err := NewJormEngine()
+		if err != nil {
+			b.Fatalf("new jorm engine: %v", err)
+		}
+		defer engine.Close()
+		loop(b, func(i int) error {
+			_, err := engine.Model(&User{}).Where("id = ?", int64(i%rows+1)).Update(updatedUser(i))
+			return err
+		})
+	})
+}
+
+// gormSession 打开 gorm，prepare 为 true 时开启 PrepareStmt 语句缓存
+func gormSession(b *testing.B, prepare bool) (*gorm.DB, func()) {
+	b.Helper()
+	db, err := NewGormDB()
+	if err != nil {
+		b.Fatalf("new gorm db: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		b.Fatalf("gorm db.DB(): %v", err)
+	}
+	if prepare {
+		db = db.Session(&gorm.Session{PrepareStmt: true})
+	}
+	return db, func() { sqlDB.Close() }
+}
+
+// BenchmarkGormStmtFindByID 测试 gorm 关闭 / 开启 PrepareStmt 时反复按 ID 查询
+func BenchmarkGormStmtFindByID(b *testing.B) {
+	for _, prepare := range []bool{false, true} {
+		b.Run(stmtName(prepare), func(b *testing.B) {
+			setupTestData(b)
+			db, done := gormSession(b, prepare)
+			defer done()
+			loop(b, func(i int) error {
+				var user User
+				return db.Where("id = ?", int64(i%rows+1)).First(&user).Error
+			})
+		})
+	}
+}
+
+// BenchmarkGormStmtUpdateByID 测试 gorm 关闭 / 开启 PrepareStmt 时反复按 ID 更新
+func BenchmarkGormStmtUpdateByID(b *testing.B) {
+	for _, prepare := range []bool{false, true} {
+		b.Run(stmtName(prepare), func(b *testing.B) {
+			setupTestData(b)
+			db, done := gormSession(b, prepare)
+			defer done()
+			loop(b, func(i int) error {
+				u := updatedUser(i)
+				return db.Where("id = ?", int64(i%rows+1)).Updates(&u).Error
+			})
+		})
+	}
+}
+
+// BenchmarkXormStmtFindByID 测试 xorm 默认方式与 Session.Prepare() 语句缓存下反复按 ID 查询。
+// xorm 的语句缓存属于 Session，因此开启时整个循环复用同一个 Session
+func BenchmarkXormStmtFindByID(b *testing.B) {
+	for _, prepare := range []bool{false, true} {
+		b.Run(stmtName(prepare), func(b *testing.B) {
+			setupTestData(b)
+			engine, err := NewXormEngine()
+			if err != nil {
+				b.Fatalf("new xorm engine: %v", err)
+			}
+			defer engine.Close()
+			sess := engine.NewSession()
+			defer sess.Close()
+			loop(b, func(i int) error {
+				var user User
+				id := int64(i%rows + 1)
+				var has bool
+				var err error
+				if prepare {
+					has, err = sess.Prepare().ID(id).Get(&user)
+				} else {
+					has, err = engine.ID(id).Get(&user)
+				}
+				if err == nil && !has {
+					err = fmt.Errorf("user not found: %d", id)
+				}
+				return err
+			})
+		})
+	}
+}
+
+// BenchmarkXormStmtUpdateByID 测试 xorm 默认方式与 Session.Prepare() 语句缓存下反复按 ID 更新
+func BenchmarkXormStmtUpdateByID(b *testing.B) {
+	for _, prepare := range []bool{false, true} {
+		b.Run(stmtName(prepare), func(b *testing.B) {
+			setupTestData(b)
+			engine, err := NewXormEngine()
+			if err != nil {
+				b.Fatalf("new xorm engine: %v", err)
+			}
+			defer engine.Close()
+			sess := engine.NewSession()
+			defer sess.Close()
+			loop(b, func(i int) error {
+				id := int64(i%rows + 1)
+				u := updatedUser(i)
+				u.ID = id
+				if prepare {
+					_, err := sess.Prepare().ID(id).Update(&u)
+					return err
+				}
+				_, err := engine.ID(id).Update(&u)
+				return err
+			})
+		})
+	}
+}
+
+// rawDB 以计数驱动打开 database/sql，与 ORM 走同一条统计路径
+func rawDB(b *testing.B) *sql.DB {
+	b.Helper()
+	t, err := benchTarget()
+	if err != nil {
+		b.Fatalf("load config: %v", err)
+	}
+	db, err := sql.Open(t.ORMDriver(), t.DSN)
+	if err != nil {
+		b.Fatalf("open raw db: %v", err)
+	}
+	return db
+}
+
+// BenchmarkRawStmtFindByID 是 database/sql 直接查询与预编译语句的基线
+func BenchmarkRawStmtFindByID(b *testing.B) {
+	const query = "SELECT id, username, age FROM users WHERE id = ?"
+	for _, prepare := range []bool{false, true} {
+		b.Run(stmtName(prepare), func(b *testing.B) {
+			setupTestData(b)
+			db := rawDB(b)
+			defer db.Close()
+			var stmt *sql.Stmt
+			if prepare {
+				var err error
+				if stmt, err = db.Prepare(query); err != nil {
+					b.Fatalf("prepare: %v", err)
+				}
+				defer stmt.Close()
+			}
+			loop(b, func(i int) error {
+				var u User
+				id := int64(i%rows + 1)
+				var row *sql.Row
+				if prepare {
+					row = stmt.QueryRow(id)
+				} else {
+					row = db.QueryRow(query, id)
+				}
+				return row.Scan(&u.ID, &u.Name, &u.Age)
+			})
+		})
+	}
+}
+
+// BenchmarkRawStmtUpdateByID 是 database/sql 直接执行与预编译语句的更新基线
+func BenchmarkRawStmtUpdateByID(b *testing.B) {
+	const query = "UPDATE users SET username = ?, age = ? WHERE id = ?"
+	for _, prepare := range []bool{false, true} {
+		b.Run(stmtName(prepare), func(b *testing.B) {
+			setupTestData(b)
+			db := rawDB(b)
+			defer db.Close()
+			var stmt *sql.Stmt
+			if prepare {
+				var err error
+				if stmt, err = db.Prepare(query); err != nil {
+					b.Fatalf("prepare: %v", err)
+				}
+				defer stmt.Close()
+			}
+			loop(b, func(i int) error {
+				u := updatedUser(i)
+				id := int64(i%rows + 1)
+				var err error
+				if prepare {
+					_, err = stmt.Exec(u.Name, u.Age, id)
+				} else {
+					_, err = db.Exec(query, u.Name, u.Age, id)
+				}
+				return err
+			})
+		})
+	}
+}
+
+func stmtName(prepare bool) string {
+	if prepare {
+		return "stmt=on"
+	}
+	return "stmt=off"
+}
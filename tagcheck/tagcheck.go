@@ -0,0 +1,216 @@
+// Package tagcheck 检查同时带 jorm / gorm / xorm tag 的模型：用三种 ORM 各自的解析器得到
+// 表名以及每个字段实际映射的列名、主键、自增与可空，三者不一致时报错
+package tagcheck
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shrek82/jorm/model"
+	"gorm.io/gorm/schema"
+	"xorm.io/xorm"
+
+	"goapi/report"
+)
+
+// Column 是一个 ORM 对结构体字段的映射
+type Column struct {
+	Field    string
+	Name     string
+	PK       bool
+	AutoIncr bool
+	// Nullable 为 false 表示建表时带 NOT NULL (主键总是非空)
+	Nullable bool
+}
+
+func (c Column) String() string {
+	s := c.Name
+	if c.PK {
+		s += " pk"
+	}
+	if c.AutoIncr {
+		s += " autoincr"
+	}
+	if !c.Nullable {
+		s += " notnull"
+	}
+	return s
+}
+
+// Mapping 是一个 ORM 对模型的完整映射，Columns 按字段名排序
+type Mapping struct {
+	ORM     string
+	Table   string
+	Columns []Column
+}
+
+// column 返回字段对应的列，字段不映射时返回 false
+func (m Mapping) column(field string) (Column, bool) {
+	i := slices.IndexFunc(m.Columns, func(c Column) bool { return c.Field == field })
+	if i < 0 {
+		return Column{}, false
+	}
+	return m.Columns[i], true
+}
+
+// Resolve 用 orm 自己的解析器解析模型，model 是结构体或其指针
+func Resolve(orm string, v any) (Mapping, error) {
+	var (
+		m   Mapping
+		err error
+	)
+	switch orm {
+	case report.ORMJorm:
+		m, err = resolveJorm(v)
+	case report.ORMGorm:
+		m, err = resolveGorm(v)
+	case report.ORMXorm:
+		m, err = resolveXorm(v)
+	default:
+		return Mapping{}, fmt.Errorf("unknown orm %q", orm)
+	}
+	if err != nil {
+		return Mapping{}, fmt.Errorf("%s: %w", orm, err)
+	}
+	m.ORM = orm
+	slices.SortFunc(m.Columns, func(a, b Column) int { return strings.Compare(a.Field, b.Field) })
+	return m, nil
+}
+
+func resolveJorm(v any) (Mapping, error) {
+	mdl, err := model.GetModel(v)
+	if err != nil {
+		return Mapping{}, err
+	}
+	m := Mapping{Table: mdl.TableName}
+	for _, f := range mdl.Fields {
+		m.Columns = append(m.Columns, Column{
+			Field: f.Name, Name: f.Column, PK: f.IsPK, AutoIncr: f.IsAuto,
+			Nullable: !f.NotNull && !f.IsPK,
+		})
+	}
+	return m, nil
+}
+
+func resolveGorm(v any) (Mapping, error) {
+	// 与 gorm.Open 的默认命名策略相同
+	s, err := schema.Parse(v, &sync.Map{}, schema.NamingStrategy{IdentifierMaxLength: 64})
+	if err != nil {
+		return Mapping{}, err
+	}
+	m := Mapping{Table: s.Table}
+	for _, f := range s.Fields {
+		if f.DBName == "" {
+			continue
+		}
+		m.Columns = append(m.Columns, Column{
+			Field: f.Name, Name: f.DBName, PK: f.PrimaryKey, AutoIncr: f.AutoIncrement,
+			Nullable: !f.NotNull && !f.PrimaryKey,
+		})
+	}
+	return m, nil
+}
+
+// xormEngine 只用于解析模型，不会连接数据库，映射规则与 xorm.NewEngine 的默认值相同
+var xormEngine = sync.OnceValues(func() (*xorm.Engine, error) {
+	return xorm.NewEngine("sqlite3", "file:tagcheck?mode=memory")
+})
+
+func resolveXorm(v any) (Mapping, error) {
+	engine, err := xormEngine()
+	if err != nil {
+		return Mapping{}, err
+	}
+	t, err := engine.TableInfo(v)
+	if err != nil {
+		return Mapping{}, err
+	}
+	m := Mapping{Table: t.Name}
+	for _, c := range t.Columns() {
+		m.Columns = append(m.Columns, Column{
+			Field: c.FieldName, Name: c.Name, PK: c.IsPrimaryKey, AutoIncr: c.IsAutoIncrement,
+			Nullable: c.Nullable && !c.IsPrimaryKey,
+		})
+	}
+	return m, nil
+}
+
+// Check 解析模型并比较三种 ORM 的映射，不一致时返回的错误列出每一处差异
+func Check(v any) error {
+	var maps []Mapping
+	for _, orm := range []string{report.ORMJorm, report.ORMGorm, report.ORMXorm} {
+		m, err := Resolve(orm, v)
+		if err != nil {
+			return err
+		}
+		maps = append(maps, m)
+	}
+	if diffs := Compare(maps...); len(diffs) > 0 {
+		return fmt.Errorf("%s: %w", typeName(v), errors.Join(diffs...))
+	}
+	return nil
+}
+
+// Compare 比较多个映射的表名与每个字段的列，返回每一处差异
+func Compare(maps ...Mapping) []error {
+	var diffs []error
+	if len(maps) < 2 {
+		return nil
+	}
+	tables := map[string][]string{}
+	for _, m := range maps {
+		tables[m.Table] = append(tables[m.Table], m.ORM)
+	}
+	if len(tables) > 1 {
+		diffs = append(diffs, fmt.Errorf("table: %s", describe(maps, func(m Mapping) string { return m.Table })))
+	}
+
+	var fields []string
+	for _, m := range maps {
+		for _, c := range m.Columns {
+			if !slices.Contains(fields, c.Field) {
+				fields = append(fields, c.Field)
+			}
+		}
+	}
+	slices.Sort(fields)
+	for _, field := range fields {
+		desc := func(m Mapping) string {
+			c, ok := m.column(field)
+			if !ok {
+				return "(not mapped)"
+			}
+			return c.String()
+		}
+		first := desc(maps[0])
+		for _, m := range maps[1:] {
+			if desc(m) != first {
+				diffs = append(diffs, fmt.Errorf("field %s: %s", field, describe(maps, desc)))
+				break
+			}
+		}
+	}
+	return diffs
+}
+
+// describe 输出每个 ORM 的值，例如 jorm=username gorm=username xorm=user_name
+func describe(maps []Mapping, value func(Mapping) string) string {
+	parts := make([]string, len(maps))
+	for i, m := range maps {
+		parts[i] = fmt.Sprintf("%s=%q", m.ORM, value(m))
+	}
+	return strings.Join(parts, " ")
+}
+
+func typeName(v any) string {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.String()
+}
@@ -0,0 +1,84 @@
+package tagcheck
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"goapi/coldstart_bench"
+	"goapi/create_bench"
+	"goapi/find_bench"
+	"goapi/logger_bench"
+	"goapi/manymodels_bench"
+	"goapi/migrate_bench"
+	"goapi/scenario"
+	"goapi/spec_bench"
+	"goapi/stmt_bench"
+	"goapi/update_bench"
+)
+
+// TestBenchmarkModels 检查每个 benchmark 包使用的模型，新增 benchmark 包后在这里登记
+func TestBenchmarkModels(t *testing.T) {
+	models := []any{
+		&create_bench.User{},
+		&find_bench.User{},
+		&update_bench.User{},
+		&logger_bench.User{},
+		&stmt_bench.User{},
+		&coldstart_bench.User{},
+		&spec_bench.User{},
+		&scenario.User{},
+		&migrate_bench.UserWithEmail{},
+		&migrate_bench.UserUniqueName{},
+	}
+	models = append(models, migrate_bench.Models...)
+	for _, m := range manymodels_bench.Models {
+		models = append(models, m())
+	}
+	for _, m := range models {
+		if err := Check(m); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// TestUserMapping 固定 User 的映射，与 create_table.sql 的列一致
+func TestUserMapping(t *testing.T) {
+	m, err := Resolve("xorm", &create_bench.User{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := fmt.Sprintf("%s %v", m.Table, m.Columns)
+	want := "users [age id pk autoincr notnull username]"
+	if got != want {
+		t.Errorf("mapping = %s, want %s", got, want)
+	}
+}
+
+type mismatch struct {
+	ID       int64  `jorm:"pk;auto" gorm:"primaryKey;autoIncrement" xorm:"'id' pk autoincr"`
+	UserName string `jorm:"column:username" gorm:"column:username;not null" xorm:"user_name"`
+	Age      int    `jorm:"column:age" gorm:"-" xorm:"'age'"`
+	Email    string `jorm:"notnull" gorm:"not null" xorm:"notnull"`
+}
+
+func (mismatch) TableName() string { return "mismatch" }
+
+func TestCheckMismatch(t *testing.T) {
+	err := Check(&mismatch{})
+	if err == nil {
+		t.Fatal("expected mismatch")
+	}
+	msg := err.Error()
+	for _, want := range []string{
+		`field UserName: jorm="username" gorm="username notnull" xorm="user_name"`,
+		`field Age: jorm="age" gorm="(not mapped)" xorm="age"`,
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("missing %q in:\n%s", want, msg)
+		}
+	}
+	if strings.Contains(msg, "field Email") || strings.Contains(msg, "field ID") || strings.Contains(msg, "table") {
+		t.Errorf("unexpected diff in:\n%s", msg)
+	}
+}
@@ -2,54 +2,33 @@ package update_bench
 
 import (
 	"database/sql"
-	"fmt"
-	"os"
 
 	"github.com/shrek82/jorm"
-	"gorm.io/driver/sqlite"
+	"goapi/scenario"
 	"gorm.io/gorm"
 	"xorm.io/xorm"
-
-	_ "github.com/mattn/go-sqlite3"
 )
 
-// DefaultDSN 从环境变量读取 DSN，找不到则使用本地 SQLite 数据库
-func DefaultDSN() string {
-	if dsn := os.Getenv("BENCH_DSN"); dsn != "" {
-		return dsn
+// benchTarget 是本包 benchmark 使用的连接，见 scenario.BenchTarget
+var benchTarget = scenario.BenchTarget
+
+// DefaultDSN 返回配置生成的 DSN，配置无效时返回错误
+func DefaultDSN() (string, error) {
+	t, err := benchTarget()
+	if err != nil {
+		return "", err
 	}
-	// 使用本地 SQLite 文件数据库
-	return "test.db"
+	return t.DSN, nil
 }
 
 // NewSQLDB 返回底层 *sql.DB，主要用于在 benchmark 中做 DELETE 等操作
-func NewSQLDB() (*sql.DB, error) {
-	return sql.Open("sqlite3", DefaultDSN())
-}
+func NewSQLDB() (*sql.DB, error) { return scenario.BenchSQLDB() }
 
-// NewJormEngine 初始化 jorm 引擎（返回 *jorm.DB）
-func NewJormEngine() (*jorm.DB, error) {
-	db, err := jorm.Open("sqlite3", DefaultDSN(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("open jorm: %w", err)
-	}
-	return db, nil
-}
+// NewJormEngine 初始化 jorm 引擎（返回 *jorm.DB），见 scenario.OpenJorm
+func NewJormEngine() (*jorm.DB, error) { return scenario.BenchJorm() }
 
 // NewGormDB 初始化 gorm DB
-func NewGormDB() (*gorm.DB, error) {
-	db, err := gorm.Open(sqlite.Open(DefaultDSN()), &gorm.Config{})
-	if err != nil {
-		return nil, fmt.Errorf("open gorm: %w", err)
-	}
-	return db, nil
-}
+func NewGormDB() (*gorm.DB, error) { return scenario.BenchGorm() }
 
 // NewXormEngine 初始化 xorm Engine
-func NewXormEngine() (*xorm.Engine, error) {
-	engine, err := xorm.NewEngine("sqlite3", DefaultDSN())
-	if err != nil {
-		return nil, fmt.Errorf("open xorm: %w", err)
-	}
-	return engine, nil
-}
+func NewXormEngine() (*xorm.Engine, error) { return scenario.BenchXorm() }
@@ -1,40 +1,53 @@
 package update_bench
 
 import (
+	"database/sql"
 	"fmt"
 	"testing"
+
+	"goapi/scenario"
+	"goapi/sqlcount/sqlcounttest"
 )
 
 // setupTestData 在每个 benchmark 开始前准备测试数据
 func setupTestData(b *testing.B, count int) {
 	b.Helper()
-	sqlDB, err := NewSQLDB()
+	t, err := benchTarget()
 	if err != nil {
-		b.Fatalf("open sql db: %v", err)
-	}
-	defer sqlDB.Close()
-
-	// 清空表
-	if _, err := sqlDB.Exec("DELETE FROM users"); err != nil {
-		b.Fatalf("delete users: %v", err)
+		b.Fatalf("load config: %v", err)
 	}
-	if _, err := sqlDB.Exec("DELETE FROM sqlite_sequence WHERE name='users'"); err != nil {
-		// 忽略错误
+	// 清空表、重置自增 ID 并插入测试数据，按配置的驱动执行对应的 SQL
+	if err := t.Seed(count); err != nil {
+		b.Fatalf("seed users: %v", err)
 	}
+}
+
+// conditionRows 是 UpdateByCondition 预置的数据量
+const conditionRows = 5000
 
-	// 插入测试数据
-	stmt, err := sqlDB.Prepare("INSERT INTO users (username, age) VALUES (?, ?)")
+// resetDB 返回不计数的连接，UpdateByCondition 每次操作后用它在计时之外恢复数据
+func resetDB(b *testing.B) *sql.DB {
+	b.Helper()
+	db, err := NewSQLDB()
 	if err != nil {
-		b.Fatalf("prepare insert: %v", err)
+		b.Fatalf("open reset db: %v", err)
 	}
-	defer stmt.Close()
+	b.Cleanup(func() { db.Close() })
+	return db
+}
 
-	for i := 1; i <= count; i++ {
-		_, err := stmt.Exec(fmt.Sprintf("user_%d", i), 20+i%30)
-		if err != nil {
-			b.Fatalf("insert data: %v", err)
-		}
+// resetAges 暂停计时，把第 i 次条件更新修改的行恢复为预置值，保证每次操作匹配的行数不随 b.N 漂移
+func resetAges(b *testing.B, db *sql.DB, ageMin, ageMax int) {
+	b.StopTimer()
+	if err := scenario.ResetAges(db, ageMin, ageMax); err != nil {
+		b.Fatalf("reset ages: %v", err)
 	}
+	b.StartTimer()
+}
+
+// reportAffected 上报 rows-affected/op
+func reportAffected(b *testing.B, affected int64) {
+	b.ReportMetric(float64(affected)/float64(b.N), scenario.RowsAffectedMetric)
 }
 
 // BenchmarkJormUpdateByID 测试 jorm 根据 ID 更新单条记录
@@ -48,7 +61,10 @@ func BenchmarkJormUpdateByID(b *testing.B) {
 	}
 	defer engine.Close()
 
+	var affected int64
+
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		// 更新 ID 在 1-1000 之间的随机记录
 		queryID := int64(i%1000 + 1)
@@ -62,10 +78,12 @@ func BenchmarkJormUpdateByID(b *testing.B) {
 		if err != nil {
 			b.Fatalf("jorm update: %v", err)
 		}
-		if result == 0 {
-			b.Fatalf("jorm update affected 0 rows")
+		if result != 1 {
+			b.Fatalf("jorm update affected %d rows, want 1", result)
 		}
+		affected += result
 	}
+	reportAffected(b, affected)
 }
 
 // BenchmarkGormUpdateByID 测试 gorm 根据 ID 更新单条记录
@@ -82,7 +100,10 @@ func BenchmarkGormUpdateByID(b *testing.B) {
 	}
 	defer sqlDB.Close()
 
+	var affected int64
+
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		queryID := int64(i%1000 + 1)
 		updatedUser := User{
@@ -94,10 +115,12 @@ func BenchmarkGormUpdateByID(b *testing.B) {
 		if result.Error != nil {
 			b.Fatalf("gorm update: %v", result.Error)
 		}
-		if result.RowsAffected == 0 {
-			b.Fatalf("gorm update affected 0 rows")
+		if result.RowsAffected != 1 {
+			b.Fatalf("gorm update affected %d rows, want 1", result.RowsAffected)
 		}
+		affected += result.RowsAffected
 	}
+	reportAffected(b, affected)
 }
 
 // BenchmarkXormUpdateByID 测试 xorm 根据 ID 更新单条记录
@@ -110,7 +133,10 @@ func BenchmarkXormUpdateByID(b *testing.B) {
 	}
 	defer engine.Close()
 
+	var total int64
+
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		queryID := int64(i%1000 + 1)
 		updatedUser := User{
@@ -123,16 +149,18 @@ func BenchmarkXormUpdateByID(b *testing.B) {
 		if err != nil {
 			b.Fatalf("xorm update: %v", err)
 		}
-		if affected == 0 {
-			b.Fatalf("xorm update affected 0 rows")
+		if affected != 1 {
+			b.Fatalf("xorm update affected %d rows, want 1", affected)
 		}
+		total += affected
 	}
+	reportAffected(b, total)
 }
 
 // BenchmarkJormUpdateByCondition 测试 jorm 根据条件更新多条记录
 func BenchmarkJormUpdateByCondition(b *testing.B) {
 	// 准备 5000 条测试数据
-	setupTestData(b, 5000)
+	setupTestData(b, conditionRows)
 
 	engine, err := NewJormEngine()
 	if err != nil {
@@ -140,7 +168,11 @@ func BenchmarkJormUpdateByCondition(b *testing.B) {
 	}
 	defer engine.Close()
 
+	reset := resetDB(b)
+	var affected int64
+
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		// 更新年龄在某个范围内的用户
 		ageMin := 20 + i%10
@@ -154,15 +186,18 @@ func BenchmarkJormUpdateByCondition(b *testing.B) {
 		if err != nil {
 			b.Fatalf("jorm update: %v", err)
 		}
-		if result == 0 {
-			// 允许影响 0 行的情况，因为可能没有匹配的记录
+		if want := scenario.ConditionAffected(conditionRows, ageMin, ageMax); result != want {
+			b.Fatalf("jorm update affected %d rows, want %d", result, want)
 		}
+		affected += result
+		resetAges(b, reset, ageMin, ageMax)
 	}
+	reportAffected(b, affected)
 }
 
 // BenchmarkGormUpdateByCondition 测试 gorm 根据条件更新多条记录
 func BenchmarkGormUpdateByCondition(b *testing.B) {
-	setupTestData(b, 5000)
+	setupTestData(b, conditionRows)
 
 	db, err := NewGormDB()
 	if err != nil {
@@ -174,7 +209,11 @@ func BenchmarkGormUpdateByCondition(b *testing.B) {
 	}
 	defer sqlDB.Close()
 
+	reset := resetDB(b)
+	var affected int64
+
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		ageMin := 20 + i%10
 		ageMax := ageMin + 5
@@ -187,15 +226,18 @@ func BenchmarkGormUpdateByCondition(b *testing.B) {
 		if result.Error != nil {
 			b.Fatalf("gorm update: %v", result.Error)
 		}
-		if result.RowsAffected == 0 {
-			// 允许影响 0 行的情况，因为可能没有匹配的记录
+		if want := scenario.ConditionAffected(conditionRows, ageMin, ageMax); result.RowsAffected != want {
+			b.Fatalf("gorm update affected %d rows, want %d", result.RowsAffected, want)
 		}
+		affected += result.RowsAffected
+		resetAges(b, reset, ageMin, ageMax)
 	}
+	reportAffected(b, affected)
 }
 
 // BenchmarkXormUpdateByCondition 测试 xorm 根据条件更新多条记录
 func BenchmarkXormUpdateByCondition(b *testing.B) {
-	setupTestData(b, 5000)
+	setupTestData(b, conditionRows)
 
 	engine, err := NewXormEngine()
 	if err != nil {
@@ -203,7 +245,11 @@ func BenchmarkXormUpdateByCondition(b *testing.B) {
 	}
 	defer engine.Close()
 
+	reset := resetDB(b)
+	var total int64
+
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		ageMin := 20 + i%10
 		ageMax := ageMin + 5
@@ -216,10 +262,13 @@ func BenchmarkXormUpdateByCondition(b *testing.B) {
 		if err != nil {
 			b.Fatalf("xorm update: %v", err)
 		}
-		if affected == 0 {
-			// 允许影响 0 行的情况，因为可能没有匹配的记录
+		if want := scenario.ConditionAffected(conditionRows, ageMin, ageMax); affected != want {
+			b.Fatalf("xorm update affected %d rows, want %d", affected, want)
 		}
+		total += affected
+		resetAges(b, reset, ageMin, ageMax)
 	}
+	reportAffected(b, total)
 }
 
 // BenchmarkJormUpdateAll 测试 jorm 更新所有记录
@@ -233,7 +282,10 @@ func BenchmarkJormUpdateAll(b *testing.B) {
 	}
 	defer engine.Close()
 
+	var affected int64
+
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		updatedUser := User{
 			Age: 25 + i%10,
@@ -244,10 +296,12 @@ func BenchmarkJormUpdateAll(b *testing.B) {
 		if err != nil {
 			b.Fatalf("jorm update: %v", err)
 		}
-		if result == 0 {
-			b.Fatalf("jorm update affected 0 rows")
+		if result != 1000 {
+			b.Fatalf("jorm update affected %d rows, want 1000", result)
 		}
+		affected += result
 	}
+	reportAffected(b, affected)
 }
 
 // BenchmarkGormUpdateAll 测试 gorm 更新所有记录
@@ -264,7 +318,10 @@ func BenchmarkGormUpdateAll(b *testing.B) {
 	}
 	defer sqlDB.Close()
 
+	var affected int64
+
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		updatedUser := User{
 			Age: 25 + i%10,
@@ -275,10 +332,12 @@ func BenchmarkGormUpdateAll(b *testing.B) {
 		if result.Error != nil {
 			b.Fatalf("gorm update: %v", result.Error)
 		}
-		if result.RowsAffected == 0 {
-			b.Fatalf("gorm update affected 0 rows")
+		if result.RowsAffected != 1000 {
+			b.Fatalf("gorm update affected %d rows, want 1000", result.RowsAffected)
 		}
+		affected += result.RowsAffected
 	}
+	reportAffected(b, affected)
 }
 
 // BenchmarkXormUpdateAll 测试 xorm 更新所有记录
@@ -291,7 +350,10 @@ func BenchmarkXormUpdateAll(b *testing.B) {
 	}
 	defer engine.Close()
 
+	var total int64
+
 	b.ResetTimer()
+	defer sqlcounttest.Measure(b)()
 	for i := 0; i < b.N; i++ {
 		updatedUser := User{
 			Age: 25 + i%10,
@@ -301,8 +363,10 @@ func BenchmarkXormUpdateAll(b *testing.B) {
 		if err != nil {
 			b.Fatalf("xorm update: %v", err)
 		}
-		if affected == 0 {
-			b.Fatalf("xorm update affected 0 rows")
+		if affected != 1000 {
+			b.Fatalf("xorm update affected %d rows, want 1000", affected)
 		}
+		total += affected
 	}
+	reportAffected(b, total)
 }
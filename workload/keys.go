@@ -0,0 +1,113 @@
+package workload
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+)
+
+// 键分布
+const (
+	Uniform = "uniform" // 所有键等概率
+	Zipfian = "zipfian" // 少数热点键 (打散后分布在整个键空间)
+	Latest  = "latest"  // 越新插入的键越热
+)
+
+// Distributions 是可用的键分布
+var Distributions = []string{Uniform, Zipfian, Latest}
+
+// zipfianTheta 与 YCSB 默认值相同
+const zipfianTheta = 0.99
+
+// KeyChooser 从 [0, n) 中选择一个键的下标，n 会随着插入增长
+type KeyChooser interface {
+	Next(r *rand.Rand, n int64) int64
+}
+
+// NewKeyChooser 按名称创建键分布
+func NewKeyChooser(dist string) (KeyChooser, error) {
+	switch dist {
+	case Uniform:
+		return uniform{}, nil
+	case Zipfian:
+		return &scrambled{z: newZipfian(zipfianTheta)}, nil
+	case Latest:
+		return &latest{z: newZipfian(zipfianTheta)}, nil
+	}
+	return nil, fmt.Errorf("unknown distribution %q (want uniform, zipfian or latest)", dist)
+}
+
+type uniform struct{}
+
+func (uniform) Next(r *rand.Rand, n int64) int64 { return r.Int64N(n) }
+
+// zipfian 是 YCSB ZipfianGenerator 的实现 (Gray 等人的 "Quickly Generating Billion-Record Synthetic Databases")，
+// 下标 0 最热。键空间增长时增量更新 zeta，不需要重新计算
+type zipfian struct {
+	theta, alpha, zeta2 float64
+	n                   int64
+	zetan, eta          float64
+}
+
+func newZipfian(theta float64) *zipfian {
+	return &zipfian{
+		theta: theta,
+		alpha: 1 / (1 - theta),
+		zeta2: zeta(0, 2, theta, 0),
+	}
+}
+
+// zeta 在 sum (前 from 项之和) 的基础上累加到第 to 项
+func zeta(from, to int64, theta, sum float64) float64 {
+	for i := from; i < to; i++ {
+		sum += 1 / math.Pow(float64(i+1), theta)
+	}
+	return sum
+}
+
+func (z *zipfian) Next(r *rand.Rand, n int64) int64 {
+	if n != z.n {
+		if n > z.n {
+			z.zetan = zeta(z.n, n, z.theta, z.zetan)
+		} else {
+			z.zetan = zeta(0, n, z.theta, 0)
+		}
+		z.n = n
+		z.eta = (1 - math.Pow(2/float64(n), 1-z.theta)) / (1 - z.zeta2/z.zetan)
+	}
+	u := r.Float64()
+	uz := u * z.zetan
+	if uz < 1 {
+		return 0
+	}
+	if uz < 1+math.Pow(0.5, z.theta) {
+		return min(1, n-1)
+	}
+	return min(int64(float64(n)*math.Pow(z.eta*u-z.eta+1, z.alpha)), n-1)
+}
+
+// scrambled 把 zipfian 的热点键用 FNV 散列打散到整个键空间，与 YCSB 的 ScrambledZipfianGenerator 思路一致
+type scrambled struct {
+	z *zipfian
+}
+
+func (s *scrambled) Next(r *rand.Rand, n int64) int64 {
+	k := s.z.Next(r, n)
+	h := fnv.New64a()
+	var buf [8]byte
+	for i := range buf {
+		buf[i] = byte(k >> (8 * i))
+	}
+	h.Write(buf[:])
+	return int64(h.Sum64() % uint64(n))
+}
+
+// latest 让最近插入的键最热：下标按 zipfian 从键空间末尾往前取
+type latest struct {
+	z *zipfian
+}
+
+func (l *latest) Next(r *rand.Rand, n int64) int64 {
+	return n - 1 - l.z.Next(r, n)
+}
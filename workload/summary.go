@@ -0,0 +1,40 @@
+package workload
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"goapi/histogram"
+	"goapi/report"
+)
+
+// WriteSummary 输出每个负载、每个 ORM 的吞吐与各操作的延迟分位数 (微秒)
+func WriteSummary(w io.Writer, runs []*Run) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "| 负载 | ORM 框架 | 吞吐 (ops/s) | 操作 | 次数 | 平均 (µs) | p50 (µs) | p95 (µs) | p99 (µs) | p999 (µs) |")
+	fmt.Fprintln(bw, "|-----|---------|-------------|-----|-----|----------|---------|---------|---------|----------|")
+	for _, run := range runs {
+		first := true
+		for _, op := range Ops {
+			h, ok := run.Latencies[op]
+			if !ok {
+				continue
+			}
+			label := "| | | |"
+			if first {
+				label = fmt.Sprintf("| %s (%s) | %s | %s |", run.Workload, run.Dist, strings.ToUpper(run.ORM),
+					report.FormatInt(run.Result.Metrics["ops/s"]))
+				first = false
+			}
+			fmt.Fprintf(bw, "%s %s | %d | %s | %s | %s | %s | %s |\n", label, op, h.Count(),
+				histogram.Micros(h.Mean()), quantile(h, 0.50), quantile(h, 0.95), quantile(h, 0.99), quantile(h, 0.999))
+		}
+	}
+	return bw.Flush()
+}
+
+func quantile(h *histogram.Histogram, q float64) string {
+	return histogram.Micros(float64(h.Quantile(q)))
+}
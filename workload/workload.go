@@ -0,0 +1,219 @@
+// Package workload 实现 YCSB 风格的混合读写负载 (A-F)，通过 scenario.Adapter 对任意 ORM 运行，
+// 输出整体吞吐与每种操作的延迟
+package workload
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"strings"
+	"time"
+
+	"goapi/histogram"
+	"goapi/report"
+	"goapi/scenario"
+	"goapi/sqlcount"
+)
+
+// 操作类型
+const (
+	OpRead   = "read"
+	OpUpdate = "update"
+	OpInsert = "insert"
+	OpScan   = "scan"
+	OpRMW    = "rmw" // read-modify-write：先读再按读到的值更新
+)
+
+// Ops 是全部操作类型，报告按此顺序输出
+var Ops = []string{OpRead, OpUpdate, OpInsert, OpScan, OpRMW}
+
+// Workload 是一组操作比例与默认键分布，比例之和为 1
+type Workload struct {
+	Name string
+	Mix  map[string]float64
+	Dist string
+	// MaxScan 是 scan 操作的最大长度，实际长度在 [1, MaxScan] 中均匀选择
+	MaxScan int
+}
+
+// All 是 YCSB 核心负载 A-F
+var All = []Workload{
+	{Name: "A", Mix: map[string]float64{OpRead: 0.5, OpUpdate: 0.5}, Dist: Zipfian},
+	{Name: "B", Mix: map[string]float64{OpRead: 0.95, OpUpdate: 0.05}, Dist: Zipfian},
+	{Name: "C", Mix: map[string]float64{OpRead: 1}, Dist: Zipfian},
+	{Name: "D", Mix: map[string]float64{OpRead: 0.95, OpInsert: 0.05}, Dist: Latest},
+	{Name: "E", Mix: map[string]float64{OpScan: 0.95, OpInsert: 0.05}, Dist: Zipfian, MaxScan: 100},
+	{Name: "F", Mix: map[string]float64{OpRead: 0.5, OpRMW: 0.5}, Dist: Zipfian},
+}
+
+// Lookup 按名称 (不区分大小写，可带 workload 前缀) 查找负载
+func Lookup(name string) (Workload, bool) {
+	name = strings.TrimPrefix(strings.ToLower(name), "workload")
+	for _, w := range All {
+		if strings.EqualFold(w.Name, name) {
+			return w, true
+		}
+	}
+	return Workload{}, false
+}
+
+// Options 控制一次负载运行
+type Options struct {
+	// Records 是预置的记录数
+	Records int
+	// Ops 是操作次数，Duration 大于 0 时改为按时间运行
+	Ops      int
+	Duration time.Duration
+	// Dist 非空时覆盖负载默认的键分布
+	Dist string
+	// Seed 是随机数种子，相同种子下各 ORM 执行完全相同的操作序列
+	Seed uint64
+}
+
+// Run 是一次负载运行的结果
+type Run struct {
+	Workload string
+	ORM      string
+	Dist     string
+	Result   report.Result
+	// Latencies 是每种操作的延迟直方图
+	Latencies map[string]*histogram.Histogram
+}
+
+// Execute 预置数据后在 orm 上运行负载
+func Execute(t *scenario.Target, orm string, w Workload, opts Options) (*Run, error) {
+	if opts.Records <= 0 {
+		opts.Records = 1000
+	}
+	if opts.Ops <= 0 && opts.Duration <= 0 {
+		opts.Ops = 10000
+	}
+	dist := w.Dist
+	if opts.Dist != "" {
+		dist = opts.Dist
+	}
+	keys, err := NewKeyChooser(dist)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.Seed(opts.Records); err != nil {
+		return nil, err
+	}
+	a, err := scenario.Open(orm, *t)
+	if err != nil {
+		return nil, err
+	}
+	defer a.Close()
+
+	ops := make([]string, 0, len(w.Mix))
+	for _, op := range Ops {
+		if w.Mix[op] > 0 {
+			ops = append(ops, op)
+		}
+	}
+	r := rand.New(rand.NewPCG(opts.Seed, 0x9e3779b97f4a7c15))
+	run := &Run{Workload: w.Name, ORM: orm, Dist: dist, Latencies: make(map[string]*histogram.Histogram)}
+	// 单线程运行，插入的 ID 按自增顺序分配，键空间为 [1, count]
+	count := int64(opts.Records)
+
+	runtime.GC()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	counts := sqlcount.Default.Snapshot()
+	start := time.Now()
+	n := 0
+	for ; opts.Duration > 0 || n < opts.Ops; n++ {
+		if opts.Duration > 0 && n%64 == 0 && time.Since(start) >= opts.Duration {
+			break
+		}
+		op := choose(r, ops, w.Mix)
+		id := keys.Next(r, count) + 1
+		begin := time.Now()
+		if err := do(a, r, op, id, n, w.MaxScan); err != nil {
+			return nil, fmt.Errorf("%s workload %s %s id=%d: %w", orm, w.Name, op, id, err)
+		}
+		h, ok := run.Latencies[op]
+		if !ok {
+			h = histogram.New()
+			run.Latencies[op] = h
+		}
+		h.RecordDuration(time.Since(begin))
+		if op == OpInsert {
+			count++
+		}
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+
+	res := report.Result{
+		Name:        fmt.Sprintf("%sWorkload%s/dist=%s", scenario.ORMPrefix(orm), w.Name, dist),
+		Package:     "jormbench",
+		ORM:         orm,
+		Scenario:    fmt.Sprintf("Workload%s/dist=%s", w.Name, dist),
+		Procs:       runtime.GOMAXPROCS(0),
+		N:           n,
+		NsPerOp:     float64(elapsed.Nanoseconds()) / float64(n),
+		BytesPerOp:  float64(after.TotalAlloc-before.TotalAlloc) / float64(n),
+		AllocsPerOp: float64(after.Mallocs-before.Mallocs) / float64(n),
+		Metrics:     sqlcount.Metrics(sqlcount.Default.Snapshot().Sub(counts), n),
+	}
+	res.Metrics["ops/s"] = float64(n) / elapsed.Seconds()
+	for op, h := range run.Latencies {
+		res.Metrics[op+"-mean-ns"] = h.Mean()
+		res.Metrics[op+"-p99-ns"] = float64(h.Quantile(0.99))
+	}
+	run.Result = res
+	return run, nil
+}
+
+// choose 按比例随机选择操作类型
+func choose(r *rand.Rand, ops []string, mix map[string]float64) string {
+	u := r.Float64()
+	for _, op := range ops {
+		if u < mix[op] {
+			return op
+		}
+		u -= mix[op]
+	}
+	return ops[len(ops)-1]
+}
+
+func do(a scenario.Adapter, r *rand.Rand, op string, id int64, i, maxScan int) error {
+	switch op {
+	case OpRead:
+		var u scenario.User
+		return a.FindByID(id, &u)
+	case OpUpdate:
+		_, err := a.UpdateByID(id, scenario.User{Name: fmt.Sprintf("updated_user_%d", i), Age: 30 + i%20})
+		return err
+	case OpInsert:
+		return a.Insert(&scenario.User{Name: fmt.Sprintf("user_%d", i), Age: 20 + i%30})
+	case OpScan:
+		var users []scenario.User
+		return a.Scan(id, 1+r.IntN(max(maxScan, 1)), &users)
+	case OpRMW:
+		var u scenario.User
+		if err := a.FindByID(id, &u); err != nil {
+			return err
+		}
+		_, err := a.UpdateByID(id, scenario.User{Name: u.Name, Age: u.Age%50 + 1})
+		return err
+	}
+	return fmt.Errorf("unknown op %q", op)
+}
+
+// Histograms 返回每种操作的直方图条目，名称为结果名加 /op=<操作>，可保存为直方图文件
+func (r *Run) Histograms() []histogram.Entry {
+	var entries []histogram.Entry
+	for _, op := range Ops {
+		if h, ok := r.Latencies[op]; ok {
+			entries = append(entries, histogram.Entry{
+				Name:      r.Result.Name + "/op=" + op,
+				ORM:       r.ORM,
+				Scenario:  r.Result.Scenario + "/op=" + op,
+				Histogram: h,
+			})
+		}
+	}
+	return entries
+}
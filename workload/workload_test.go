@@ -0,0 +1,73 @@
+package workload
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"goapi/scenario"
+)
+
+func TestZipfianSkew(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	z := newZipfian(zipfianTheta)
+	const n, draws = 1000, 100000
+	hist := make([]int, n)
+	for i := 0; i < draws; i++ {
+		k := z.Next(r, n)
+		if k < 0 || k >= n {
+			t.Fatalf("key %d out of range", k)
+		}
+		hist[k]++
+	}
+	// theta=0.99 时下标 0 约占 1/zeta(1000) ≈ 13%
+	if p := float64(hist[0]) / draws; p < 0.10 || p > 0.17 {
+		t.Errorf("P(0) = %.3f, want about 0.13", p)
+	}
+	if hist[0] <= hist[1] || hist[1] <= hist[10] {
+		t.Errorf("not decreasing: %d %d %d", hist[0], hist[1], hist[10])
+	}
+}
+
+func TestLatestFavorsNewKeys(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	l, err := NewKeyChooser(Latest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hits := 0
+	for i := 0; i < 10000; i++ {
+		// 键空间增长时最新的键应当最热
+		n := int64(1000 + i/10)
+		if l.Next(r, n) >= n-10 {
+			hits++
+		}
+	}
+	if hits < 3000 {
+		t.Errorf("only %d/10000 draws hit the 10 newest keys", hits)
+	}
+}
+
+func TestExecute(t *testing.T) {
+	target, err := scenario.SQLiteTarget(scenario.StorageMemory, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.Close()
+	for _, name := range []string{"A", "E", "F"} {
+		w, _ := Lookup(name)
+		run, err := Execute(target, "jorm", w, Options{Records: 50, Ops: 200, Seed: 7})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var total uint64
+		for op, h := range run.Latencies {
+			if w.Mix[op] == 0 {
+				t.Errorf("workload %s ran unexpected op %s", name, op)
+			}
+			total += h.Count()
+		}
+		if total != 200 || run.Result.N != 200 || run.Result.Scenario != "Workload"+name+"/dist=zipfian" {
+			t.Errorf("workload %s: total=%d result=%+v", name, total, run.Result)
+		}
+	}
+}